@@ -24,8 +24,10 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"net/http"
+	"net/url"
 	"sort"
 	"strings"
 	"sync"
@@ -132,12 +134,16 @@ func (c *clusterConnection) Do(ctx context.Context, req driver.Request) (driver.
 
 	if v := ctx.Value(keyEndpoint); v != nil {
 		if endpoint, ok := v.(string); ok {
-			// Override pool to only specific server if it is found
-			if s, ok := c.getSpecificServer(endpoint); ok {
-				server = s
-				durationPerRequest = timeout
-				serverCount = 1
+			// Override pool to only specific server
+			s, ok := c.getSpecificServer(endpoint)
+			if !ok {
+				return nil, driver.WithStack(driver.InvalidArgumentError{
+					Message: fmt.Sprintf("endpoint '%s' is not one of the configured endpoints", endpoint),
+				})
 			}
+			server = s
+			durationPerRequest = timeout
+			serverCount = 1
 		}
 	}
 
@@ -344,18 +350,33 @@ func (c *clusterConnection) getSpecificServer(endpoint string) (driver.Connectio
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
+	endpoint = normalizeEndpoint(endpoint)
 	for _, s := range c.servers {
 		for _, x := range s.Endpoints() {
-			if x == endpoint {
+			if normalizeEndpoint(x) == endpoint {
 				return s, true
 			}
 		}
 	}
 
-	// If endpoint is not found allow to use default connection pool - request will be routed thru coordinators
 	return nil, false
 }
 
+// normalizeEndpoint returns the endpoint in the form which is comparable with other endpoints:
+// the scheme and the host are lowercase and the trailing slash is removed.
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+
+	return u.String()
+}
+
 // getNextServer changes the currently used server and returns the new server.
 func (c *clusterConnection) getNextServer() driver.Connection {
 	c.mutex.Lock()
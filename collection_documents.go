@@ -121,6 +121,14 @@ type CollectionDocuments interface {
 	ImportDocuments(ctx context.Context, documents interface{}, options *ImportDocumentOptions) (ImportDocumentStatistics, error)
 }
 
+// EdgeValidationOptions holds optional options that control the validation of edges in CreateEdgesValidated.
+type EdgeValidationOptions struct {
+	// ValidateVertices enables checking that the `_from` and `_to` vertices exist before the edges are created.
+	ValidateVertices bool
+	// BatchSize is the number of vertices checked by a single query. Defaults to 1000.
+	BatchSize int
+}
+
 // ImportDocumentOptions holds optional options that control the import document process.
 type ImportDocumentOptions struct {
 	// FromPrefix is an optional prefix for the values in _from attributes. If specified, the value is automatically
@@ -70,6 +70,7 @@ const (
 	keyAsyncRequest             ContextKey = "arangodb-async-request"
 	keyAsyncID                  ContextKey = "arangodb-async-id"
 	keySkipExistCheck           ContextKey = "arangodb-skip-exist-check"
+	keyHeaders                  ContextKey = "arangodb-headers"
 )
 
 type OverwriteMode string
@@ -314,6 +315,12 @@ func WithSkipExistCheck(parent context.Context, value bool) context.Context {
 	return context.WithValue(contextOrBackground(parent), keySkipExistCheck, value)
 }
 
+// WithHeaders is used to configure a context to add custom headers (e.g. `X-Request-ID` for tracing) to the requests.
+// The headers set by the driver (e.g. `Authorization`) take precedence over the custom ones.
+func WithHeaders(parent context.Context, headers map[string]string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyHeaders, headers)
+}
+
 type contextSettings struct {
 	Silent                   bool
 	WaitForSync              bool
@@ -377,6 +384,34 @@ func ApplyVersionHeader(ctx context.Context, req Request) {
 	req.SetHeader("x-arango-driver", val)
 }
 
+// ApplyHeaders adds the custom headers configured with WithHeaders to the request.
+// The headers which are already set in the request (given in existing) are not overwritten.
+// The header names are compared case-insensitively.
+func ApplyHeaders(ctx context.Context, req Request, existing map[string]string) {
+	if ctx == nil {
+		return
+	}
+
+	headers, ok := ctx.Value(keyHeaders).(map[string]string)
+	if !ok {
+		return
+	}
+
+	for key, value := range headers {
+		found := false
+		for k := range existing {
+			if strings.EqualFold(k, key) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			req.SetHeader(key, value)
+		}
+	}
+}
+
 // applyContextSettings returns the settings configured in the context in the given request.
 // It then returns information about the applied settings that may be needed later in API implementation functions.
 func applyContextSettings(ctx context.Context, req Request) contextSettings {
@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver"
+	arangohttp "github.com/arangodb/go-driver/http"
+)
+
+// TestCursorBatchesWithTrailingSlashEndpoint reads several batches of a cursor, which pins the follow-up requests
+// to the endpoint of the first response. The configured endpoint differs from it by the trailing slash.
+func TestCursorBatchesWithTrailingSlashEndpoint(t *testing.T) {
+	const batches = 3
+	var batch int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/_db/db/_api/cursor" {
+			atomic.StoreInt32(&batch, 0)
+			w.WriteHeader(http.StatusCreated)
+		} else if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		current := atomic.AddInt32(&batch, 1) - 1
+		fmt.Fprintf(w, `{"error":false,"code":200,"id":"1","result":[%d],"hasMore":%t}`, current, current < batches-1)
+	}))
+	defer server.Close()
+
+	conn, err := arangohttp.NewConnection(arangohttp.ConnectionConfig{
+		Endpoints: []string{server.URL + "/"},
+	})
+	require.NoError(t, err)
+
+	c, err := driver.NewClient(driver.ClientConfig{Connection: conn})
+	require.NoError(t, err)
+
+	ctx := driver.WithSkipExistCheck(context.Background(), true)
+	db, err := c.Database(ctx, "db")
+	require.NoError(t, err)
+
+	cursor, err := db.Query(context.Background(), "FOR i IN 0..2 RETURN i", nil)
+	require.NoError(t, err)
+	defer cursor.Close()
+
+	var result []int
+	for cursor.HasMore() {
+		var i int
+		_, err := cursor.ReadDocument(context.Background(), &i)
+		require.NoError(t, err)
+		result = append(result, i)
+	}
+	require.Equal(t, []int{0, 1, 2}, result)
+}
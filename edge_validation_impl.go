@@ -0,0 +1,168 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+const defaultEdgeValidationBatchSize = 1000
+
+// edgeEndpoints contains the vertices referenced by an edge.
+type edgeEndpoints struct {
+	From string `json:"_from"`
+	To   string `json:"_to"`
+}
+
+// CreateEdgesValidated creates multiple edges in the given collection, like Collection.CreateDocuments.
+// When validation is enabled in the given options, it first checks that the vertices referenced by the
+// `_from` and `_to` fields of the edges exist. Edges which fail the validation are not created and
+// an error is returned at their index in the errors slice: an InvalidArgumentError when `_from` or `_to`
+// is missing, or a NotFoundError when a vertex does not exist.
+// The returned slices always have the same number of entries as the `edges` slice.
+// `WithReturnNew` is not supported when validation is enabled.
+// If the create request itself fails or one of the arguments is invalid, an error is returned.
+func CreateEdgesValidated(ctx context.Context, col Collection, edges interface{}, opts *EdgeValidationOptions) (DocumentMetaSlice, ErrorSlice, error) {
+	if opts == nil || !opts.ValidateVertices {
+		return col.CreateDocuments(ctx, edges)
+	}
+
+	edgesVal := reflect.ValueOf(edges)
+	switch edgesVal.Kind() {
+	case reflect.Array, reflect.Slice:
+		// OK
+	default:
+		return nil, nil, WithStack(InvalidArgumentError{Message: fmt.Sprintf("edges data must be of kind Array, got %s", edgesVal.Kind())})
+	}
+	edgeCount := edgesVal.Len()
+
+	// Collect the vertices of all edges
+	endpoints := make([]edgeEndpoints, edgeCount)
+	errs := make(ErrorSlice, edgeCount)
+	var vertices []string
+	known := map[string]bool{}
+	for i := 0; i < edgeCount; i++ {
+		data, err := json.Marshal(edgesVal.Index(i).Interface())
+		if err != nil {
+			return nil, nil, WithStack(err)
+		}
+		if err := json.Unmarshal(data, &endpoints[i]); err != nil {
+			return nil, nil, WithStack(InvalidArgumentError{Message: fmt.Sprintf("edge at index %d must be an object: %s", i, err)})
+		}
+		if endpoints[i].From == "" || endpoints[i].To == "" {
+			errs[i] = WithStack(InvalidArgumentError{Message: fmt.Sprintf("edge at index %d must have _from and _to", i)})
+			continue
+		}
+		for _, id := range []string{endpoints[i].From, endpoints[i].To} {
+			if !known[id] {
+				known[id] = true
+				vertices = append(vertices, id)
+			}
+		}
+	}
+
+	// Find the vertices which do not exist
+	missing, err := findMissingVertices(ctx, col.Database(), vertices, opts.BatchSize)
+	if err != nil {
+		return nil, nil, WithStack(err)
+	}
+
+	valid := reflect.MakeSlice(reflect.SliceOf(edgesVal.Type().Elem()), 0, edgeCount)
+	var validIndexes []int
+	for i := 0; i < edgeCount; i++ {
+		if errs[i] != nil {
+			continue
+		}
+		for _, id := range []string{endpoints[i].From, endpoints[i].To} {
+			if missing[id] {
+				errs[i] = WithStack(newArangoError(http.StatusNotFound, ErrArangoDocumentNotFound, fmt.Sprintf("vertex '%s' does not exist", id)))
+				break
+			}
+		}
+		if errs[i] == nil {
+			valid = reflect.Append(valid, edgesVal.Index(i))
+			validIndexes = append(validIndexes, i)
+		}
+	}
+
+	metas := make(DocumentMetaSlice, edgeCount)
+	if len(validIndexes) == 0 {
+		return metas, errs, nil
+	}
+
+	// Create the valid edges
+	createdMetas, createdErrs, err := col.CreateDocuments(ctx, valid.Interface())
+	if err != nil {
+		return nil, nil, WithStack(err)
+	}
+	for i, index := range validIndexes {
+		if i < len(createdMetas) {
+			metas[index] = createdMetas[i]
+		}
+		if i < len(createdErrs) {
+			errs[index] = createdErrs[i]
+		}
+	}
+
+	return metas, errs, nil
+}
+
+// findMissingVertices returns the IDs of the given vertices which do not exist in the database.
+func findMissingVertices(ctx context.Context, db Database, vertices []string, batchSize int) (map[string]bool, error) {
+	if batchSize <= 0 {
+		batchSize = defaultEdgeValidationBatchSize
+	}
+
+	missing := map[string]bool{}
+	for start := 0; start < len(vertices); start += batchSize {
+		end := start + batchSize
+		if end > len(vertices) {
+			end = len(vertices)
+		}
+
+		cursor, err := db.Query(ctx, "FOR id IN @ids FILTER DOCUMENT(id) == null RETURN id", map[string]interface{}{
+			"ids": vertices[start:end],
+		})
+		if err != nil {
+			return nil, WithStack(err)
+		}
+
+		for {
+			var id string
+			if _, err := cursor.ReadDocument(ctx, &id); IsNoMoreDocuments(err) {
+				break
+			} else if err != nil {
+				cursor.Close()
+				return nil, WithStack(err)
+			}
+			missing[id] = true
+		}
+		if err := cursor.Close(); err != nil {
+			return nil, WithStack(err)
+		}
+	}
+
+	return missing, nil
+}
@@ -27,6 +27,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
 	"sync/atomic"
 
@@ -115,11 +116,28 @@ func (a *basicAuthentication) Configure(req driver.Request) error {
 	return nil
 }
 
+// refreshableAuthentication is implemented by authentications which credentials can expire,
+// so new credentials have to be obtained when the server rejects the current ones.
+type refreshableAuthentication interface {
+	httpAuthentication
+
+	// ConfigureWithGeneration is called instead of Configure and returns the generation of the credentials
+	// which have been applied to the request.
+	ConfigureWithGeneration(req driver.Request) (uint64, error)
+
+	// Refresh obtains new credentials, unless it has already been done since the given generation.
+	Refresh(ctx context.Context, conn driver.Connection, generation uint64) error
+}
+
 // jwtAuthentication implements JWT token authentication.
+// The token is obtained again when it is rejected by the server (e.g. because it has expired).
 type jwtAuthentication struct {
 	userName string
 	password string
-	token    string
+
+	mutex      sync.RWMutex
+	token      string
+	generation uint64
 }
 
 type jwtOpenRequest struct {
@@ -134,6 +152,45 @@ type jwtOpenResponse struct {
 
 // Prepare is called before the first request of the given connection is made.
 func (a *jwtAuthentication) Prepare(ctx context.Context, conn driver.Connection) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.obtainToken(ctx, conn)
+}
+
+// Configure is called for every request made on a connection.
+func (a *jwtAuthentication) Configure(req driver.Request) error {
+	_, err := a.ConfigureWithGeneration(req)
+	return err
+}
+
+// ConfigureWithGeneration is called for every request made on a connection.
+// It returns the generation of the token which has been applied to the request.
+func (a *jwtAuthentication) ConfigureWithGeneration(req driver.Request) (uint64, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	req.SetHeader("Authorization", "bearer "+a.token)
+	return a.generation, nil
+}
+
+// Refresh obtains a new token unless another request has already done it since the given generation.
+// Concurrent callers wait for the one which obtains the token, so the server is asked only once.
+func (a *jwtAuthentication) Refresh(ctx context.Context, conn driver.Connection, generation uint64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.generation != generation {
+		// The token has been already refreshed.
+		return nil
+	}
+
+	return a.obtainToken(ctx, conn)
+}
+
+// obtainToken requests a new token from the server and stores it.
+// The caller must hold the write lock.
+func (a *jwtAuthentication) obtainToken(ctx context.Context, conn driver.Connection) error {
 	// Prepare request
 	r, err := conn.NewRequest("POST", "/_open/auth")
 	if err != nil {
@@ -161,17 +218,12 @@ func (a *jwtAuthentication) Prepare(ctx context.Context, conn driver.Connection)
 
 	// Store token
 	a.token = data.Token
+	a.generation++
 
 	// Ok
 	return nil
 }
 
-// Configure is called for every request made on a connection.
-func (a *jwtAuthentication) Configure(req driver.Request) error {
-	req.SetHeader("Authorization", "bearer "+a.token)
-	return nil
-}
-
 // newAuthenticatedConnection creates a Connection that applies the given connection on the given underlying connection.
 func newAuthenticatedConnection(conn driver.Connection, auth httpAuthentication) (driver.Connection, error) {
 	if conn == nil {
@@ -213,7 +265,15 @@ func (c *authenticatedConnection) Do(ctx context.Context, req driver.Request) (d
 		}
 	}
 	// Configure the request for authentication.
-	if err := c.auth.Configure(req); err != nil {
+	refreshable, isRefreshable := c.auth.(refreshableAuthentication)
+	var generation uint64
+	var err error
+	if isRefreshable {
+		generation, err = refreshable.ConfigureWithGeneration(req)
+	} else {
+		err = c.auth.Configure(req)
+	}
+	if err != nil {
 		// Failed to configure request for authentication
 		return nil, driver.WithStack(err)
 	}
@@ -222,6 +282,18 @@ func (c *authenticatedConnection) Do(ctx context.Context, req driver.Request) (d
 	if err != nil {
 		return nil, driver.WithStack(err)
 	}
+	if isRefreshable && resp.StatusCode() == http.StatusUnauthorized {
+		// Credentials have probably expired, so obtain new ones and retry the request once.
+		if err := refreshable.Refresh(ctx, c.conn, generation); err != nil {
+			return nil, driver.WithStack(err)
+		}
+		if err := c.auth.Configure(req); err != nil {
+			return nil, driver.WithStack(err)
+		}
+		if resp, err = c.conn.Do(ctx, req); err != nil {
+			return nil, driver.WithStack(err)
+		}
+	}
 	return resp, nil
 }
 
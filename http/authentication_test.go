@@ -23,9 +23,19 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver"
 )
@@ -74,3 +84,121 @@ func TestIsAuthenticationTheSame(t *testing.T) {
 	}
 
 }
+
+// expiringTokenServer is a fake server which issues JWT tokens that expire after the given time.
+type expiringTokenServer struct {
+	validity time.Duration
+
+	mutex  sync.Mutex
+	tokens map[string]time.Time
+	issued int32
+}
+
+func (s *expiringTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/_open/auth" {
+		var req jwtOpenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.UserName != "root" || req.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		token := fmt.Sprintf("token-%d", atomic.AddInt32(&s.issued, 1))
+		s.mutex.Lock()
+		s.tokens[token] = time.Now().Add(s.validity)
+		s.mutex.Unlock()
+		json.NewEncoder(w).Encode(jwtOpenResponse{Token: token})
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+	s.mutex.Lock()
+	expiresAt, found := s.tokens[token]
+	s.mutex.Unlock()
+	if !found || time.Now().After(expiresAt) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":true,"code":401,"errorNum":11,"errorMessage":"not authorized to execute this request"}`))
+		return
+	}
+	w.Write([]byte(`{"version":"3.12.0"}`))
+}
+
+func newExpiringTokenConnection(t *testing.T, validity time.Duration) (*expiringTokenServer, driver.Connection) {
+	server := &expiringTokenServer{
+		validity: validity,
+		tokens:   map[string]time.Time{},
+	}
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	conn, err := NewConnection(ConnectionConfig{Endpoints: []string{ts.URL}})
+	require.NoError(t, err)
+	conn, err = conn.SetAuthentication(driver.JWTAuthentication("root", "secret"))
+	require.NoError(t, err)
+
+	return server, conn
+}
+
+func requestVersion(conn driver.Connection) error {
+	req, err := conn.NewRequest("GET", "/_api/version")
+	if err != nil {
+		return err
+	}
+	resp, err := conn.Do(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	return resp.CheckStatus(http.StatusOK)
+}
+
+func TestJWTAuthenticationRefreshesExpiredToken(t *testing.T) {
+	server, conn := newExpiringTokenConnection(t, 500*time.Millisecond)
+
+	require.NoError(t, requestVersion(conn))
+	require.NoError(t, requestVersion(conn))
+	require.EqualValues(t, 1, atomic.LoadInt32(&server.issued))
+
+	time.Sleep(600 * time.Millisecond)
+
+	require.NoError(t, requestVersion(conn))
+	require.EqualValues(t, 2, atomic.LoadInt32(&server.issued))
+}
+
+func TestJWTAuthenticationRefreshesTokenOnceForConcurrentRequests(t *testing.T) {
+	server, conn := newExpiringTokenConnection(t, 500*time.Millisecond)
+
+	require.NoError(t, requestVersion(conn))
+	require.EqualValues(t, 1, atomic.LoadInt32(&server.issued))
+
+	time.Sleep(600 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, requestVersion(conn))
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 2, atomic.LoadInt32(&server.issued))
+}
+
+func TestJWTAuthenticationInvalidCredentials(t *testing.T) {
+	server := &expiringTokenServer{
+		validity: time.Minute,
+		tokens:   map[string]time.Time{},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	conn, err := NewConnection(ConnectionConfig{Endpoints: []string{ts.URL}})
+	require.NoError(t, err)
+	conn, err = conn.SetAuthentication(driver.JWTAuthentication("root", "wrong"))
+	require.NoError(t, err)
+
+	req, err := conn.NewRequest("GET", "/_api/version")
+	require.NoError(t, err)
+	_, err = conn.Do(context.Background(), req)
+	require.Error(t, err)
+}
@@ -0,0 +1,118 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+const (
+	// DefaultRequestCompressionMinSize is the default minimum size in bytes of the request body to be compressed.
+	DefaultRequestCompressionMinSize = 1024
+)
+
+// CompressionConfig configures the gzip compression of the requests and responses.
+type CompressionConfig struct {
+	// RequestCompressionEnabled enables the gzip compression of the request bodies.
+	RequestCompressionEnabled bool
+	// RequestCompressionMinSize is the minimum size in bytes of the request body to be compressed.
+	// Smaller bodies are sent without compression, because it is not worth it.
+	// The default is 1024 (DefaultRequestCompressionMinSize).
+	RequestCompressionMinSize int
+	// RequestCompressionLevel sets the gzip compression level, see https://pkg.go.dev/compress/gzip#pkg-constants.
+	// The default is gzip.DefaultCompression.
+	RequestCompressionLevel int
+	// ResponseCompressionEnabled asks the server to compress the responses with gzip.
+	// The responses are decompressed transparently.
+	ResponseCompressionEnabled bool
+}
+
+// compressBody returns the gzip compressed body when the compression of the requests is enabled
+// and the body is large enough. Otherwise, nil is returned.
+func (c *CompressionConfig) compressBody(body []byte) ([]byte, error) {
+	if c == nil || !c.RequestCompressionEnabled {
+		return nil, nil
+	}
+
+	minSize := c.RequestCompressionMinSize
+	if minSize == 0 {
+		minSize = DefaultRequestCompressionMinSize
+	}
+	if len(body) < minSize {
+		return nil, nil
+	}
+
+	level := c.RequestCompressionLevel
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(body)/2))
+	w, err := gzip.NewWriterLevel(buf, level)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, driver.WithStack(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decompressBody returns the reader of the response body according to its content encoding.
+// The golang transport removes the `Content-Encoding` header when it decompresses the body itself,
+// and the server may ignore the accepted encoding, so the body is returned as it is in these cases.
+func decompressBody(resp *http.Response, body []byte) ([]byte, error) {
+	if len(body) == 0 {
+		return body, nil
+	}
+
+	var r io.ReadCloser
+	var err error
+	switch resp.Header.Get("Content-Encoding") {
+	case "gzip":
+		r, err = gzip.NewReader(bytes.NewReader(body))
+	case "deflate":
+		r, err = zlib.NewReader(bytes.NewReader(body))
+	default:
+		return body, nil
+	}
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	defer r.Close()
+
+	result, err := io.ReadAll(r)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newEchoServer starts a server which decompresses the request body and responds with it.
+// The response is compressed when compressResponse is set and the client accepts gzip.
+func newEchoServer(t *testing.T, compressResponse bool, recorded *http.Header) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*recorded = r.Header.Clone()
+
+		var reader io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gr, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			reader = gr
+		}
+
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		if compressResponse && strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.Header().Set("Content-Encoding", "gzip")
+			gw := gzip.NewWriter(w)
+			defer gw.Close()
+			gw.Write(body)
+			return
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConnectionCompression(t *testing.T) {
+	type document struct {
+		Value string `json:"value"`
+	}
+
+	large := document{Value: strings.Repeat("a", 2*DefaultRequestCompressionMinSize)}
+	small := document{Value: "a"}
+
+	tests := map[string]struct {
+		compression             *CompressionConfig
+		compressResponse        bool
+		body                    document
+		expectedRequestEncoding string
+	}{
+		"no compression": {
+			body: large,
+		},
+		"large body is compressed": {
+			compression:             &CompressionConfig{RequestCompressionEnabled: true},
+			body:                    large,
+			expectedRequestEncoding: "gzip",
+		},
+		"small body is not compressed": {
+			compression: &CompressionConfig{RequestCompressionEnabled: true},
+			body:        small,
+		},
+		"custom threshold": {
+			compression: &CompressionConfig{
+				RequestCompressionEnabled: true,
+				RequestCompressionMinSize: 1,
+				RequestCompressionLevel:   gzip.BestSpeed,
+			},
+			body:                    small,
+			expectedRequestEncoding: "gzip",
+		},
+		"compressed response": {
+			compression: &CompressionConfig{
+				RequestCompressionEnabled:  true,
+				ResponseCompressionEnabled: true,
+			},
+			compressResponse:        true,
+			body:                    large,
+			expectedRequestEncoding: "gzip",
+		},
+		"server ignores the accepted encoding": {
+			compression:      &CompressionConfig{ResponseCompressionEnabled: true},
+			compressResponse: false,
+			body:             large,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var recorded http.Header
+			server := newEchoServer(t, test.compressResponse, &recorded)
+
+			conn, err := NewConnection(ConnectionConfig{
+				Endpoints:   []string{server.URL},
+				Compression: test.compression,
+			})
+			require.NoError(t, err)
+
+			req, err := conn.NewRequest("POST", "_api/document/c")
+			require.NoError(t, err)
+			_, err = req.SetBody(test.body)
+			require.NoError(t, err)
+
+			resp, err := conn.Do(context.Background(), req)
+			require.NoError(t, err)
+
+			var output document
+			require.NoError(t, resp.ParseBody("", &output))
+			require.Equal(t, test.body, output)
+
+			require.Equal(t, test.expectedRequestEncoding, recorded.Get("Content-Encoding"))
+			if test.compression != nil && test.compression.ResponseCompressionEnabled {
+				require.Equal(t, "gzip", recorded.Get("Accept-Encoding"))
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"net/http"
 	"net/http/httptrace"
 	"net/url"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -60,6 +61,18 @@ type ConnectionConfig struct {
 	// TLSConfig holds settings used to configure a TLS (HTTPS) connection.
 	// This is only used for endpoints using the HTTPS scheme.
 	TLSConfig *tls.Config
+	// ClientCertificate holds the PEM encoded certificate used for the TLS client authentication (mutual TLS).
+	// It is added to the `Certificates` of the `TLSConfig`, so there is no need to build the `tls.Config` by hand.
+	// It must be used together with ClientKey.
+	ClientCertificate []byte
+	// ClientKey holds the PEM encoded private key of the ClientCertificate.
+	ClientKey []byte
+	// ClientCertificateFile holds the path to the PEM encoded client certificate.
+	// It is used only when ClientCertificate is not set.
+	ClientCertificateFile string
+	// ClientKeyFile holds the path to the PEM encoded private key of the client certificate.
+	// It is used only when ClientKey is not set.
+	ClientKeyFile string
 	// Transport allows the use of a custom round tripper.
 	// If Transport is not of type `*http.Transport`, the `TLSConfig` property is not used.
 	// Otherwise a `TLSConfig` property other than `nil` will overwrite the `TLSClientConfig`
@@ -84,10 +97,19 @@ type ConnectionConfig struct {
 	// The default is 32 (DefaultConnLimit).
 	// Set this value to -1 if you do not want any upper limit.
 	ConnLimit int
+	// Compression configures the compression of the requests and responses.
+	// By default, the requests are not compressed.
+	Compression *CompressionConfig
 }
 
 // NewConnection creates a new HTTP connection based on the given configuration settings.
 func NewConnection(config ConnectionConfig) (driver.Connection, error) {
+	tlsConfig, err := config.loadClientCertificate()
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	config.TLSConfig = tlsConfig
+
 	c, err := cluster.NewConnection(config.ConnectionConfig, func(endpoint string) (driver.Connection, error) {
 		conn, err := newHTTPConnection(endpoint, config)
 		if err != nil {
@@ -101,6 +123,51 @@ func NewConnection(config ConnectionConfig) (driver.Connection, error) {
 	return c, nil
 }
 
+// loadClientCertificate returns the TLS configuration extended with the client certificate (if provided).
+// The given TLSConfig is never modified. An error is returned when the private key does not match the certificate.
+func (c ConnectionConfig) loadClientCertificate() (*tls.Config, error) {
+	certPEM, keyPEM := c.ClientCertificate, c.ClientKey
+
+	if len(certPEM) == 0 && c.ClientCertificateFile != "" {
+		data, err := os.ReadFile(c.ClientCertificateFile)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		certPEM = data
+	}
+
+	if len(keyPEM) == 0 && c.ClientKeyFile != "" {
+		data, err := os.ReadFile(c.ClientKeyFile)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		keyPEM = data
+	}
+
+	if len(certPEM) == 0 && len(keyPEM) == 0 {
+		return c.TLSConfig, nil
+	}
+
+	if len(certPEM) == 0 || len(keyPEM) == 0 {
+		return nil, driver.WithStack(driver.InvalidArgumentError{Message: "client certificate and client key must be provided together"})
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("invalid client certificate: %s", err)})
+	}
+
+	var tlsConfig *tls.Config
+	if c.TLSConfig != nil {
+		tlsConfig = c.TLSConfig.Clone()
+	} else {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+
+	return tlsConfig, nil
+}
+
 // newHTTPConnection creates a new HTTP connection for a single endpoint and the remainder of the given configuration settings.
 func newHTTPConnection(endpoint string, config ConnectionConfig) (driver.Connection, error) {
 	if config.ConnLimit == 0 {
@@ -178,6 +245,7 @@ func newHTTPConnection(endpoint string, config ConnectionConfig) (driver.Connect
 		contentType: config.ContentType,
 		client:      httpClient,
 		connPool:    connPool,
+		compression: config.Compression,
 	}
 	return c, nil
 }
@@ -188,6 +256,7 @@ type httpConnection struct {
 	contentType driver.ContentType
 	client      *http.Client
 	connPool    chan int
+	compression *CompressionConfig
 }
 
 // String returns the endpoint as string
@@ -230,8 +299,9 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 	}
 
 	driver.ApplyVersionHeader(ctx, req)
+	driver.ApplyHeaders(ctx, req, request.hdr)
 
-	r, err := request.createHTTPRequest(c.endpoint)
+	r, err := request.createHTTPRequest(c.endpoint, c.compression)
 	rctx := ctx
 	if rctx == nil {
 		rctx = context.Background()
@@ -281,6 +351,9 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 	if err != nil {
 		return nil, driver.WithStack(err)
 	}
+	if body, err = decompressBody(resp, body); err != nil {
+		return nil, driver.WithStack(err)
+	}
 	if rawResponse != nil {
 		*rawResponse = body
 	}
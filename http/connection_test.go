@@ -0,0 +1,281 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver"
+)
+
+// newSelfSignedCertificate generates a PEM encoded self-signed certificate together with its private key.
+func newSelfSignedCertificate(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// newMutualTLSServer starts a server which accepts only requests authenticated with the given client certificate.
+func newMutualTLSServer(t *testing.T, clientCertPEM []byte) *httptest.Server {
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(clientCertPEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"server":"arango","version":"3.12.0"}`))
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func sendVersionRequest(t *testing.T, conn driver.Connection) (driver.Response, error) {
+	req, err := conn.NewRequest(http.MethodGet, "_api/version")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return conn.Do(ctx, req)
+}
+
+func TestNewConnectionClientCertificate(t *testing.T) {
+	certPEM, keyPEM := newSelfSignedCertificate(t, "client")
+	server := newMutualTLSServer(t, certPEM)
+
+	t.Run("PEM bytes", func(t *testing.T) {
+		conn, err := NewConnection(ConnectionConfig{
+			Endpoints:         []string{server.URL},
+			TLSConfig:         &tls.Config{InsecureSkipVerify: true},
+			ClientCertificate: certPEM,
+			ClientKey:         keyPEM,
+		})
+		require.NoError(t, err)
+
+		resp, err := sendVersionRequest(t, conn)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+	})
+
+	t.Run("PEM files", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile := filepath.Join(dir, "client.crt")
+		keyFile := filepath.Join(dir, "client.key")
+		require.NoError(t, os.WriteFile(certFile, certPEM, 0600))
+		require.NoError(t, os.WriteFile(keyFile, keyPEM, 0600))
+
+		conn, err := NewConnection(ConnectionConfig{
+			Endpoints:             []string{server.URL},
+			TLSConfig:             &tls.Config{InsecureSkipVerify: true},
+			ClientCertificateFile: certFile,
+			ClientKeyFile:         keyFile,
+		})
+		require.NoError(t, err)
+
+		resp, err := sendVersionRequest(t, conn)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+	})
+
+	t.Run("no client certificate", func(t *testing.T) {
+		conn, err := NewConnection(ConnectionConfig{
+			Endpoints: []string{server.URL},
+			TLSConfig: &tls.Config{InsecureSkipVerify: true},
+		})
+		require.NoError(t, err)
+
+		_, err = sendVersionRequest(t, conn)
+		require.Error(t, err)
+	})
+
+	t.Run("key does not match certificate", func(t *testing.T) {
+		_, otherKeyPEM := newSelfSignedCertificate(t, "other")
+
+		_, err := NewConnection(ConnectionConfig{
+			Endpoints:         []string{server.URL},
+			ClientCertificate: certPEM,
+			ClientKey:         otherKeyPEM,
+		})
+		require.Error(t, err)
+		require.True(t, driver.IsInvalidArgument(err))
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := NewConnection(ConnectionConfig{
+			Endpoints:         []string{server.URL},
+			ClientCertificate: certPEM,
+		})
+		require.Error(t, err)
+		require.True(t, driver.IsInvalidArgument(err))
+	})
+
+	t.Run("given TLS config is not modified", func(t *testing.T) {
+		tlsConfig := &tls.Config{InsecureSkipVerify: true}
+
+		_, err := NewConnection(ConnectionConfig{
+			Endpoints:         []string{server.URL},
+			TLSConfig:         tlsConfig,
+			ClientCertificate: certPEM,
+			ClientKey:         keyPEM,
+		})
+		require.NoError(t, err)
+		require.Empty(t, tlsConfig.Certificates)
+	})
+}
+
+// newNamedServer starts a server which responds with its name to every request.
+func newNamedServer(t *testing.T, name string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"server":"` + name + `","version":"3.12.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConnectionWithEndpoint(t *testing.T) {
+	serverA := newNamedServer(t, "a")
+	serverB := newNamedServer(t, "b")
+
+	conn, err := NewConnection(ConnectionConfig{
+		Endpoints: []string{serverA.URL, serverB.URL},
+	})
+	require.NoError(t, err)
+
+	serverName := func(ctx context.Context) (string, error) {
+		req, err := conn.NewRequest("GET", "_api/version")
+		if err != nil {
+			return "", err
+		}
+		resp, err := conn.Do(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		var version driver.VersionInfo
+		if err := resp.ParseBody("", &version); err != nil {
+			return "", err
+		}
+		return version.Server, nil
+	}
+
+	t.Run("pinned endpoint is used", func(t *testing.T) {
+		for _, server := range []*httptest.Server{serverB, serverA} {
+			ctx := driver.WithEndpoint(context.Background(), server.URL)
+			for i := 0; i < 3; i++ {
+				name, err := serverName(ctx)
+				require.NoError(t, err)
+				require.Equal(t, map[*httptest.Server]string{serverA: "a", serverB: "b"}[server], name)
+			}
+		}
+	})
+
+	t.Run("unknown endpoint", func(t *testing.T) {
+		ctx := driver.WithEndpoint(context.Background(), "http://127.0.0.1:1")
+
+		_, err := serverName(ctx)
+		require.Error(t, err)
+		require.True(t, driver.IsInvalidArgument(err))
+	})
+}
+
+func TestConnectionWithHeaders(t *testing.T) {
+	var recorded http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"server":"arango"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := NewConnection(ConnectionConfig{
+		Endpoints: []string{server.URL},
+	})
+	require.NoError(t, err)
+	conn, err = conn.SetAuthentication(driver.BasicAuthentication("root", "secret"))
+	require.NoError(t, err)
+
+	sendRequest := func(ctx context.Context) error {
+		req, err := conn.NewRequest(http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		_, err = conn.Do(ctx, req)
+		return err
+	}
+
+	t.Run("custom headers are sent", func(t *testing.T) {
+		ctx := driver.WithHeaders(context.Background(), map[string]string{"X-Request-ID": "request-1"})
+
+		require.NoError(t, sendRequest(ctx))
+		require.Equal(t, "request-1", recorded.Get("X-Request-ID"))
+	})
+
+	t.Run("driver headers win", func(t *testing.T) {
+		ctx := driver.WithHeaders(context.Background(), map[string]string{
+			"authorization":   "bearer fake",
+			"x-arango-driver": "fake",
+		})
+
+		require.NoError(t, sendRequest(ctx))
+		require.Equal(t, []string{"Basic cm9vdDpzZWNyZXQ="}, recorded.Values("Authorization"))
+		require.Len(t, recorded.Values("X-Arango-Driver"), 1)
+		require.NotEqual(t, "fake", recorded.Get("X-Arango-Driver"))
+	})
+}
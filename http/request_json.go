@@ -155,7 +155,8 @@ func (r *httpRequest) WroteRequest(httptrace.WroteRequestInfo) {
 }
 
 // createHTTPRequest creates a golang http.Request based on the configured arguments.
-func (r *httpRequest) createHTTPRequest(endpoint url.URL) (*http.Request, error) {
+// The body is compressed according to the given compression configuration.
+func (r *httpRequest) createHTTPRequest(endpoint url.URL, compression *CompressionConfig) (*http.Request, error) {
 	r.written = false
 	u := endpoint
 	u.Path = ""
@@ -177,6 +178,13 @@ func (r *httpRequest) createHTTPRequest(endpoint url.URL) (*http.Request, error)
 
 	var bodyReader io.Reader
 	body := r.bodyBuilder.GetBody()
+	compressed, err := compression.compressBody(body)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if compressed != nil {
+		body = compressed
+	}
 	if body != nil {
 		bodyReader = bytes.NewReader(body)
 	}
@@ -200,6 +208,12 @@ func (r *httpRequest) createHTTPRequest(endpoint url.URL) (*http.Request, error)
 		req.Header.Set("Content-Length", strconv.Itoa(len(body)))
 		req.Header.Set("Content-Type", r.bodyBuilder.GetContentType())
 	}
+	if compressed != nil {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	if compression != nil && compression.ResponseCompressionEnabled {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
 	return req, nil
 }
 
@@ -228,3 +228,67 @@ func TestCreateEdgesNonSlice(t *testing.T) {
 		t.Errorf("Expected InvalidArgumentError, got %s", describe(err))
 	}
 }
+
+// TestCreateEdgesValidated creates edges with validation of their vertices.
+func TestCreateEdgesValidated(t *testing.T) {
+	ctx := context.Background()
+	c := createClient(t, nil)
+	db := ensureDatabase(ctx, c, "edges_test", nil, t)
+	prefix := "create_edges_validated_"
+	g := ensureGraph(ctx, db, prefix+"graph", nil, t)
+	ec := ensureEdgeCollection(ctx, g, prefix+"citiesPerState", []string{prefix + "city"}, []string{prefix + "state"}, t)
+	cities := ensureCollection(ctx, db, prefix+"city", nil, t)
+	states := ensureCollection(ctx, db, prefix+"state", nil, t)
+	from := createDocument(ctx, cities, map[string]interface{}{"name": "Venlo"}, t)
+	to := createDocument(ctx, states, map[string]interface{}{"name": "Limburg"}, t)
+
+	docs := []RouteEdge{
+		{
+			From:     from.ID.String(),
+			To:       to.ID.String(),
+			Distance: 40,
+		},
+		{
+			From:     from.ID.String(),
+			To:       prefix + "state/missing",
+			Distance: 68,
+		},
+		{
+			From:     from.ID.String(),
+			Distance: 21,
+		},
+	}
+	metas, errs, err := driver.CreateEdgesValidated(ctx, ec, docs, &driver.EdgeValidationOptions{ValidateVertices: true})
+	if err != nil {
+		t.Fatalf("Failed to create new documents: %s", describe(err))
+	}
+	if len(metas) != len(docs) || len(errs) != len(docs) {
+		t.Fatalf("Expected %d metas and errors, got %d and %d", len(docs), len(metas), len(errs))
+	}
+
+	if err := errs[0]; err != nil {
+		t.Errorf("Expected no error at index 0, got %s", describe(err))
+	}
+	var readDoc RouteEdge
+	if _, err := ec.ReadDocument(ctx, metas[0].Key, &readDoc); err != nil {
+		t.Fatalf("Failed to read document '%s': %s", metas[0].Key, describe(err))
+	}
+	if !reflect.DeepEqual(docs[0], readDoc) {
+		t.Errorf("Got wrong document. Expected %+v, got %+v", docs[0], readDoc)
+	}
+
+	if !driver.IsNotFound(errs[1]) {
+		t.Errorf("Expected NotFoundError at index 1, got %s", describe(errs[1]))
+	}
+	if !driver.IsInvalidArgument(errs[2]) {
+		t.Errorf("Expected InvalidArgumentError at index 2, got %s", describe(errs[2]))
+	}
+
+	count, err := ec.Count(ctx)
+	if err != nil {
+		t.Fatalf("Failed to count documents: %s", describe(err))
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 edge, got %d", count)
+	}
+}
@@ -186,9 +186,12 @@ type ArangoSearchAnalyzerProperties struct {
 	Options *ArangoSearchAnalyzerGeoOptions `json:"options,omitempty"`
 
 	// Latitude used by ArangoSearchAnalyzerTypeGeoPoint.
+	// It is the attribute path to the latitude value, e.g. `[]string{"location", "lat"}` for `{"location": {"lat": ...}}`.
+	// When Latitude and Longitude are empty, the input is expected to be an array of two numbers `[latitude, longitude]`.
 	Latitude []string `json:"latitude,omitempty"`
 
 	// Longitude used by ArangoSearchAnalyzerTypeGeoPoint.
+	// It is the attribute path to the longitude value, e.g. `[]string{"location", "lng"}`.
 	Longitude []string `json:"longitude,omitempty"`
 
 	// Break used by ArangoSearchAnalyzerTypeSegmentation
@@ -230,7 +233,7 @@ type ArangoSearchAnalyzerProperties struct {
 	// search pattern of %up%if%ref% (substrings of length 2 and 3 between %), but this leads to a slower search
 	// (for ref% with post-validation using the ICU regular expression engine).
 	// A value of 3 is a good default, 2 is better for short strings
-	NGramSize uint `json:"ngramSize"`
+	NGramSize uint `json:"ngramSize,omitempty"`
 }
 
 type ArangoSearchCaseType string
@@ -0,0 +1,88 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_ArangoSearchAnalyzerProperties_ML(t *testing.T) {
+	props := ArangoSearchAnalyzerProperties{
+		ModelLocation: "/models/model.bin",
+		TopK:          utils.NewType[uint64](2),
+		Threshold:     utils.NewType(0.75),
+	}
+
+	data, err := json.Marshal(props)
+	require.NoError(t, err)
+
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Equal(t, "/models/model.bin", raw["model_location"])
+	require.EqualValues(t, 2, raw["top_k"])
+	require.EqualValues(t, 0.75, raw["threshold"])
+
+	var restored ArangoSearchAnalyzerProperties
+	require.NoError(t, json.Unmarshal(data, &restored))
+	require.Equal(t, props.ModelLocation, restored.ModelLocation)
+	require.Equal(t, props.TopK, restored.TopK)
+	require.Equal(t, props.Threshold, restored.Threshold)
+}
+
+func Test_ArangoSearchAnalyzerProperties_Delimiters(t *testing.T) {
+	testCases := map[string]struct {
+		props    ArangoSearchAnalyzerProperties
+		expected string
+	}{
+		"delimiter": {
+			props:    ArangoSearchAnalyzerProperties{Delimiter: ","},
+			expected: `{"delimiter":",","stopwords":null}`,
+		},
+		"multi_delimiter": {
+			props:    ArangoSearchAnalyzerProperties{Delimiters: []string{",", ";", "||"}},
+			expected: `{"delimiters":[",",";","||"],"stopwords":null}`,
+		},
+		"wildcard": {
+			props:    ArangoSearchAnalyzerProperties{NGramSize: 3},
+			expected: `{"ngramSize":3,"stopwords":null}`,
+		},
+		"empty": {
+			expected: `{"stopwords":null}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(testCase.props)
+			require.NoError(t, err)
+			require.JSONEq(t, testCase.expected, string(data))
+
+			var restored ArangoSearchAnalyzerProperties
+			require.NoError(t, json.Unmarshal(data, &restored))
+			require.Equal(t, testCase.props, restored)
+		})
+	}
+}
@@ -22,6 +22,9 @@ package arangodb
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
 )
 
 type ClientAdmin interface {
@@ -29,6 +32,7 @@ type ClientAdmin interface {
 	ClientAdminBackup
 	ClientAdminLicense
 	ClientAdminCluster
+	ClientAdminMetrics
 
 	// ServerMode returns the current mode in which the server/cluster is operating.
 	// This call needs ArangoDB 3.3 and up.
@@ -43,6 +47,36 @@ type ClientAdmin interface {
 	// Use ClientAdminCluster.Health() to fetch the Endpoint list.
 	// For ActiveFailover, it will return an error (503 code) if the server is not the leader.
 	CheckAvailability(ctx context.Context, serverEndpoint string) error
+
+	// ExecuteAdminScript executes the given JavaScript code on the server and stores its return value in result.
+	// The result can be nil when the return value is not needed.
+	// SECURITY: the code runs with the full privileges of the server process, e.g. it can access all the data
+	// and the file system of the server. Never pass a script which contains untrusted input.
+	// The endpoint is disabled by default and must be enabled with the `--javascript.allow-admin-execute` server option.
+	// When it is disabled, an AdminScriptExecutionDisabledError is returned.
+	ExecuteAdminScript(ctx context.Context, script string, result interface{}) error
+}
+
+// AdminScriptExecutionDisabledError is returned when the execution of the admin scripts is disabled on the server.
+type AdminScriptExecutionDisabledError struct {
+	// Err is the error returned by the server.
+	Err error
+}
+
+// Error implements the error interface.
+func (a AdminScriptExecutionDisabledError) Error() string {
+	return fmt.Sprintf("execution of admin scripts is disabled on the server, enable it with the --javascript.allow-admin-execute option: %s", a.Err)
+}
+
+// Unwrap returns the error returned by the server.
+func (a AdminScriptExecutionDisabledError) Unwrap() error {
+	return a.Err
+}
+
+// IsAdminScriptExecutionDisabled returns true if the given error is an AdminScriptExecutionDisabledError.
+func IsAdminScriptExecutionDisabled(err error) bool {
+	var e AdminScriptExecutionDisabledError
+	return errors.As(err, &e)
 }
 
 type ClientAdminLog interface {
@@ -51,6 +85,9 @@ type ClientAdminLog interface {
 
 	// SetLogLevels sets log levels for a given topics.
 	SetLogLevels(ctx context.Context, logLevels LogLevels, opts *LogLevelsSetOptions) error
+
+	// ReadLogEntries returns the log entries of the server matching the given options.
+	ReadLogEntries(ctx context.Context, opts *LogEntriesOptions) (LogEntries, error)
 }
 
 type ClientAdminLicense interface {
@@ -20,7 +20,11 @@
 
 package arangodb
 
-import "context"
+import (
+	"context"
+	"sort"
+	"time"
+)
 
 type ClientAdminCluster interface {
 	// Health returns the cluster configuration & health. Not available in single server deployments (403 Forbidden).
@@ -30,8 +34,16 @@ type ClientAdminCluster interface {
 	DatabaseInventory(ctx context.Context, dbName string) (DatabaseInventory, error)
 
 	// MoveShard moves a single shard of the given collection between `fromServer` and `toServer`.
+	// It returns the ID of the agency job, which can be checked with QueryAgencyJob.
 	MoveShard(ctx context.Context, col Collection, shard ShardID, fromServer, toServer ServerID) (string, error)
 
+	// RebalanceShards computes a plan of shard moves which improves the balance of the cluster and executes it.
+	// It returns the moves which have been scheduled.
+	RebalanceShards(ctx context.Context, opts *RebalanceOptions) (RebalanceResult, error)
+
+	// QueryAgencyJob returns the status of the agency job with given ID, e.g. the one returned by MoveShard.
+	QueryAgencyJob(ctx context.Context, jobID string) (AgencyJob, error)
+
 	// CleanOutServer triggers activities to clean out a DBServer.
 	CleanOutServer(ctx context.Context, serverID ServerID) (string, error)
 
@@ -44,10 +56,164 @@ type ClientAdminCluster interface {
 	// IsCleanedOut checks if the dbServer with given ID has been cleaned out.
 	IsCleanedOut(ctx context.Context, serverID ServerID) (bool, error)
 
+	// ClusterEndpoints returns the endpoints of all coordinators of the cluster.
+	// The endpoints are converted to URLs usable by the connection, e.g. `tcp://` into `http://`.
+	ClusterEndpoints(ctx context.Context) ([]string, error)
+
+	// SynchronizeEndpoints fetches the endpoints of all coordinators and configures the connection
+	// to use them in round-robin fashion.
+	SynchronizeEndpoints(ctx context.Context) error
+
+	// SynchronizeEndpointsPeriodically calls SynchronizeEndpoints in the background every interval,
+	// until the given context is canceled. Errors are passed to onError when it is not nil.
+	SynchronizeEndpointsPeriodically(ctx context.Context, interval time.Duration, onError func(err error))
+
 	// RemoveServer is a low-level option to remove a server from a cluster.
 	// This function is suitable for servers of type coordinator or dbServer.
 	// The use of `ClientServerAdmin.Shutdown` is highly recommended above this function.
 	RemoveServer(ctx context.Context, serverID ServerID) error
+
+	// ShardDistribution returns the leaders and followers of the shards of all the collections in the given database,
+	// both as planned and as currently in place. Use it to spot the shards which followers are not in sync.
+	// Not available in single server deployments.
+	ShardDistribution(ctx context.Context, dbName string) (ShardDistribution, error)
+}
+
+// ShardDistribution contains the distribution of the shards per collection name.
+type ShardDistribution map[string]CollectionShardDistribution
+
+// CollectionShardDistribution contains the planned and the current distribution of the shards of a collection.
+type CollectionShardDistribution struct {
+	// Plan contains the planned servers of the shards.
+	Plan map[ShardID]ShardServers `json:"Plan,omitempty"`
+	// Current contains the servers which currently hold the shards.
+	Current map[ShardID]ShardServers `json:"Current,omitempty"`
+}
+
+// ShardServers describes the servers responsible for a shard.
+type ShardServers struct {
+	Leader    ServerID   `json:"leader,omitempty"`
+	Followers []ServerID `json:"followers,omitempty"`
+	// Progress contains the synchronization progress of a shard which followers are not in sync.
+	Progress *ShardSyncProgress `json:"progress,omitempty"`
+}
+
+// ShardSyncProgress describes the synchronization progress of a shard.
+type ShardSyncProgress struct {
+	Total   int `json:"total"`
+	Current int `json:"current"`
+}
+
+// OutOfSyncShards returns the sorted shards which current leader or followers differ from the planned ones.
+func (c CollectionShardDistribution) OutOfSyncShards() []ShardID {
+	var result []ShardID
+	for shard, plan := range c.Plan {
+		current, ok := c.Current[shard]
+		if !ok || current.Leader != plan.Leader || !sameServers(current.Followers, plan.Followers) {
+			result = append(result, shard)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// sameServers returns true if both lists contain the same servers regardless of their order.
+func sameServers(a, b []ServerID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	servers := make(map[ServerID]int, len(a))
+	for _, s := range a {
+		servers[s]++
+	}
+	for _, s := range b {
+		if servers[s] == 0 {
+			return false
+		}
+		servers[s]--
+	}
+	return true
+}
+
+// RebalanceOptions describes how the shards should be rebalanced.
+type RebalanceOptions struct {
+	// MaximumNumberOfMoves is the maximum number of moves to be computed.
+	MaximumNumberOfMoves *int `json:"maximumNumberOfMoves,omitempty"`
+	// LeaderChanges allows leader changes without moving data.
+	LeaderChanges *bool `json:"leaderChanges,omitempty"`
+	// MoveLeaders allows moving leaders.
+	MoveLeaders *bool `json:"moveLeaders,omitempty"`
+	// MoveFollowers allows moving followers.
+	MoveFollowers *bool `json:"moveFollowers,omitempty"`
+	// ExcludeSystemCollections ignores system collections in the rebalance plan.
+	ExcludeSystemCollections *bool `json:"excludeSystemCollections,omitempty"`
+	// PiFactor is the weighting factor which should remain untouched.
+	PiFactor *float64 `json:"piFactor,omitempty"`
+	// DatabasesExcluded is the list of database names which are excluded from the analysis.
+	DatabasesExcluded []string `json:"databasesExcluded,omitempty"`
+}
+
+// RebalanceResult contains the moves scheduled by the rebalance.
+type RebalanceResult struct {
+	Moves []RebalanceMove `json:"moves,omitempty"`
+}
+
+// RebalanceMove describes a single move of a shard.
+type RebalanceMove struct {
+	// From is the server from which the shard is moved.
+	From ServerID `json:"from"`
+	// To is the server to which the shard is moved.
+	To ServerID `json:"to"`
+	// Shard is the ID of the moved shard.
+	Shard ShardID `json:"shard"`
+	// Collection is the ID of the collection which contains the shard.
+	Collection string `json:"collection"`
+	// IsLeader is true when the leader of the shard is moved.
+	IsLeader bool `json:"isLeader"`
+}
+
+// AgencyJobStatus is the status of the agency job.
+type AgencyJobStatus string
+
+const (
+	AgencyJobStatusToDo     AgencyJobStatus = "ToDo"
+	AgencyJobStatusPending  AgencyJobStatus = "Pending"
+	AgencyJobStatusFinished AgencyJobStatus = "Finished"
+	AgencyJobStatusFailed   AgencyJobStatus = "Failed"
+)
+
+// AgencyJob describes the state of the agency job.
+type AgencyJob struct {
+	// ID is the ID of the job.
+	ID string `json:"id"`
+	// Status is the status of the job.
+	Status AgencyJobStatus `json:"status"`
+	// Job contains the details of the job.
+	Job AgencyJobDetails `json:"job"`
+}
+
+// AgencyJobDetails contains the details of the agency job.
+type AgencyJobDetails struct {
+	Type         string    `json:"type,omitempty"`
+	Database     string    `json:"database,omitempty"`
+	Collection   string    `json:"collection,omitempty"`
+	Shard        ShardID   `json:"shard,omitempty"`
+	FromServer   ServerID  `json:"fromServer,omitempty"`
+	ToServer     ServerID  `json:"toServer,omitempty"`
+	Creator      string    `json:"creator,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+	TimeCreated  time.Time `json:"timeCreated,omitempty"`
+	TimeStarted  time.Time `json:"timeStarted,omitempty"`
+	TimeFinished time.Time `json:"timeFinished,omitempty"`
+}
+
+// IsDone returns true when the job has finished or failed.
+func (a AgencyJob) IsDone() bool {
+	return a.Status == AgencyJobStatusFinished || a.Status == AgencyJobStatusFailed
 }
 
 type NumberOfServersResponse struct {
@@ -24,6 +24,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -73,6 +74,27 @@ func (c *clientAdmin) DatabaseInventory(ctx context.Context, dbName string) (Dat
 	}
 }
 
+func (c *clientAdmin) ShardDistribution(ctx context.Context, dbName string) (ShardDistribution, error) {
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(dbName), "_admin", "cluster", "shardDistribution")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Results               ShardDistribution `json:"results,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Results, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (c *clientAdmin) MoveShard(ctx context.Context, col Collection, shard ShardID, fromServer, toServer ServerID) (string, error) {
 	urlEndpoint := connection.NewUrl("_admin", "cluster", "moveShard")
 
@@ -108,6 +130,56 @@ func (c *clientAdmin) MoveShard(ctx context.Context, col Collection, shard Shard
 	}
 }
 
+func (c *clientAdmin) RebalanceShards(ctx context.Context, opts *RebalanceOptions) (RebalanceResult, error) {
+	urlEndpoint := connection.NewUrl("_admin", "cluster", "rebalance")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                RebalanceResult `json:"result"`
+	}
+
+	body := struct {
+		Version int `json:"version"`
+		*RebalanceOptions
+	}{
+		Version:          1,
+		RebalanceOptions: opts,
+	}
+
+	resp, err := connection.CallPut(ctx, c.client.connection, urlEndpoint, &response, body)
+	if err != nil {
+		return RebalanceResult{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return response.Result, nil
+	default:
+		return RebalanceResult{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientAdmin) QueryAgencyJob(ctx context.Context, jobID string) (AgencyJob, error) {
+	urlEndpoint := connection.NewUrl("_admin", "cluster", "queryAgencyJob")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		AgencyJob             `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response, connection.WithQuery("id", jobID))
+	if err != nil {
+		return AgencyJob{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.AgencyJob, nil
+	default:
+		return AgencyJob{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (c *clientAdmin) CleanOutServer(ctx context.Context, serverID ServerID) (string, error) {
 	urlEndpoint := connection.NewUrl("_admin", "cluster", "cleanOutServer")
 
@@ -216,3 +288,29 @@ func (c *clientAdmin) RemoveServer(ctx context.Context, serverID ServerID) error
 		return response.AsArangoErrorWithCode(code)
 	}
 }
+
+func (c *clientAdmin) ClusterEndpoints(ctx context.Context) ([]string, error) {
+	return connection.ClusterEndpoints(ctx, c.client.connection)
+}
+
+func (c *clientAdmin) SynchronizeEndpoints(ctx context.Context) error {
+	return connection.SynchronizeEndpoints(ctx, c.client.connection)
+}
+
+func (c *clientAdmin) SynchronizeEndpointsPeriodically(ctx context.Context, interval time.Duration, onError func(err error)) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.SynchronizeEndpoints(ctx); err != nil && onError != nil && ctx.Err() == nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,79 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func TestClientAdmin_SynchronizeEndpoints(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_api/cluster/endpoints", r.URL.Path)
+
+		endpoint := strings.Replace(serverURL, "http://", "tcp://", 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":false,"code":200,"endpoints":[{"endpoint":"` + endpoint + `"},{"endpoint":"ssl://127.0.0.2:8529"}]}`))
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	conn := connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint: connection.NewRoundRobinEndpoints([]string{server.URL}),
+	})
+	client := NewClient(conn)
+
+	endpoints, err := client.ClusterEndpoints(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{server.URL, "https://127.0.0.2:8529"}, endpoints)
+
+	require.NoError(t, client.SynchronizeEndpoints(context.Background()))
+	require.Equal(t, endpoints, conn.GetEndpoint().List())
+}
+
+func TestClientAdmin_ShardDistribution(t *testing.T) {
+	client := NewClient(newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_db/db/_admin/cluster/shardDistribution", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":false,"code":200,"results":{"users":{
+			"Plan":{"s1":{"leader":"PRMR-1","followers":["PRMR-2","PRMR-3"]},"s2":{"leader":"PRMR-2","followers":["PRMR-3"]},"s3":{"leader":"PRMR-3","followers":["PRMR-1"]}},
+			"Current":{"s1":{"leader":"PRMR-1","followers":["PRMR-3","PRMR-2"]},"s2":{"leader":"PRMR-2","followers":[],"progress":{"total":10,"current":4}}}
+		}}}`))
+	}))
+
+	distribution, err := client.ShardDistribution(context.Background(), "db")
+	require.NoError(t, err)
+	require.Len(t, distribution, 1)
+
+	users := distribution["users"]
+	require.Equal(t, ShardServers{Leader: "PRMR-1", Followers: []ServerID{"PRMR-2", "PRMR-3"}}, users.Plan["s1"])
+	require.Equal(t, &ShardSyncProgress{Total: 10, Current: 4}, users.Current["s2"].Progress)
+	require.Equal(t, []ShardID{"s2", "s3"}, users.OutOfSyncShards())
+}
@@ -22,7 +22,11 @@ package arangodb
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -90,6 +94,73 @@ type ServerHealth struct {
 	// Only for Agents
 	Leader  *string `json:"Leader,omitempty"`
 	Leading *bool   `json:"Leading,omitempty"`
+	// LastAckedTime is the time of the last acknowledgement of the server by the agency leader.
+	LastAckedTime *AckedTime `json:"LastAckedTime,omitempty"`
+}
+
+// AckedTime is the time of the last acknowledgement of a server, which is reported in two forms:
+// the agents report the number of seconds since the acknowledgement, the other servers report its timestamp.
+type AckedTime struct {
+	// Seconds is the number of seconds since the acknowledgement. It is set for the agents.
+	Seconds *float64
+	// Time is the time of the acknowledgement. It is set for the DB-Servers and Coordinators.
+	Time *time.Time
+}
+
+// MarshalJSON marshals AckedTime to the form in which it has been reported.
+func (a AckedTime) MarshalJSON() ([]byte, error) {
+	if a.Time != nil {
+		return json.Marshal(a.Time)
+	}
+
+	return json.Marshal(a.Seconds)
+}
+
+// UnmarshalJSON unmarshals AckedTime from the number of seconds or from the timestamp.
+func (a *AckedTime) UnmarshalJSON(d []byte) error {
+	var internal interface{}
+
+	if err := json.Unmarshal(d, &internal); err != nil {
+		return err
+	}
+
+	switch v := internal.(type) {
+	case float64:
+		*a = AckedTime{Seconds: &v}
+		return nil
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			*a = AckedTime{Time: &t}
+			return nil
+		}
+	}
+
+	return &json.UnmarshalTypeError{
+		Value: string(d),
+		Type:  reflect.TypeOf(a).Elem(),
+	}
+}
+
+// Leader returns the agent which is the current leader of the agency.
+// It returns false when there is no leading agent in the health information.
+func (c ClusterHealth) Leader() (ServerID, ServerHealth, bool) {
+	for id, server := range c.Health {
+		if server.Role == ServerRoleAgent && server.Leading != nil && *server.Leading {
+			return id, server, true
+		}
+	}
+
+	// The agents which are not leading know the ID of the leader.
+	for _, server := range c.Health {
+		if server.Role != ServerRoleAgent || server.Leader == nil {
+			continue
+		}
+		if leader, ok := c.Health[ServerID(*server.Leader)]; ok && leader.Role == ServerRoleAgent {
+			return ServerID(*server.Leader), leader, true
+		}
+	}
+
+	return "", ServerHealth{}, false
 }
 
 type ServerMode string
@@ -172,3 +243,42 @@ func (c *clientAdmin) CheckAvailability(ctx context.Context, serverEndpoint stri
 	_, err = c.client.Connection().Do(ctx, req, nil, http.StatusOK)
 	return errors.WithStack(err)
 }
+
+func (c *clientAdmin) ExecuteAdminScript(ctx context.Context, script string, result interface{}) error {
+	url := connection.NewUrl("_admin", "execute")
+
+	resp, body, err := connection.CallStream(ctx, c.client.connection, http.MethodPost, url,
+		connection.WithBody(strings.NewReader(script)), withPlainTextContent, connection.WithQuery("returnAsJSON", "true"))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		if result == nil || len(data) == 0 {
+			return nil
+		}
+		return errors.WithStack(json.Unmarshal(data, result))
+	case http.StatusNotFound:
+		// The endpoint is not registered when the execution of the admin scripts is disabled.
+		var response shared.ResponseStruct
+		_ = json.Unmarshal(data, &response)
+		return errors.WithStack(AdminScriptExecutionDisabledError{Err: response.AsArangoErrorWithCode(code)})
+	default:
+		var response shared.ResponseStruct
+		_ = json.Unmarshal(data, &response)
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+// withPlainTextContent sets the content type of the request body to the plain text.
+func withPlainTextContent(r connection.Request) error {
+	r.AddHeader(connection.ContentType, connection.PlainText)
+	return nil
+}
@@ -0,0 +1,141 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func Test_ClusterHealth_Leader(t *testing.T) {
+	data := `{
+		"ClusterId": "cluster",
+		"Health": {
+			"AGNT-1": {"Role": "Agent", "Status": "GOOD", "Leader": "AGNT-2", "Leading": false, "LastAckedTime": 0.25,
+				"Endpoint": "tcp://[::1]:4001", "Version": "3.12.0", "Engine": "rocksdb", "CanBeDeleted": false},
+			"AGNT-2": {"Role": "Agent", "Status": "GOOD", "Leader": "AGNT-2", "Leading": true, "LastAckedTime": 0,
+				"Endpoint": "tcp://[::1]:4002", "Version": "3.12.0", "Engine": "rocksdb", "CanBeDeleted": false},
+			"PRMR-1": {"Role": "DBServer", "Status": "GOOD", "ShortName": "DBServer0001", "CanBeDeleted": false,
+				"Version": "3.12.0", "Engine": "rocksdb", "SyncStatus": "SERVING",
+				"LastHeartbeatAcked": "2024-01-02T03:04:05Z", "LastAckedTime": "2024-01-02T03:04:06Z"}
+		}
+	}`
+
+	var health ClusterHealth
+	require.NoError(t, json.Unmarshal([]byte(data), &health))
+
+	id, leader, ok := health.Leader()
+	require.True(t, ok)
+	require.Equal(t, ServerID("AGNT-2"), id)
+	require.Equal(t, "tcp://[::1]:4002", leader.Endpoint)
+
+	agent := health.Health["AGNT-1"]
+	require.NotNil(t, agent.LastAckedTime)
+	require.NotNil(t, agent.LastAckedTime.Seconds)
+	require.Equal(t, 0.25, *agent.LastAckedTime.Seconds)
+	require.Nil(t, agent.LastAckedTime.Time)
+
+	dbServer := health.Health["PRMR-1"]
+	require.Equal(t, ServerRoleDBServer, dbServer.Role)
+	require.Equal(t, ServerStatusGood, dbServer.Status)
+	require.Equal(t, "DBServer0001", dbServer.ShortName)
+	require.Equal(t, Version("3.12.0"), dbServer.Version)
+	require.Equal(t, EngineTypeRocksDB, dbServer.Engine)
+	require.Equal(t, ServerSyncStatusServing, dbServer.SyncStatus)
+	require.NotNil(t, dbServer.LastAckedTime)
+	require.Nil(t, dbServer.LastAckedTime.Seconds)
+	require.NotNil(t, dbServer.LastAckedTime.Time)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC), *dbServer.LastAckedTime.Time)
+
+	encoded, err := json.Marshal(health)
+	require.NoError(t, err)
+	var decoded ClusterHealth
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, health, decoded)
+
+	var invalid AckedTime
+	require.Error(t, json.Unmarshal([]byte(`"yesterday"`), &invalid))
+
+	t.Run("leader known by followers only", func(t *testing.T) {
+		leading := false
+		health.Health["AGNT-2"] = ServerHealth{Role: ServerRoleAgent, Leading: &leading}
+
+		id, _, ok := health.Leader()
+		require.True(t, ok)
+		require.Equal(t, ServerID("AGNT-2"), id)
+	})
+
+	t.Run("no agents", func(t *testing.T) {
+		_, _, ok := ClusterHealth{}.Leader()
+		require.False(t, ok)
+	})
+}
+
+func Test_clientAdmin_ExecuteAdminScript(t *testing.T) {
+	var enabled bool
+	var script, contentType string
+	client := NewClient(newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		script, contentType = string(data), r.Header.Get("Content-Type")
+
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		if !enabled {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":true,"code":404,"errorNum":404,"errorMessage":"unknown path '/_admin/execute'"}`))
+			return
+		}
+
+		require.Equal(t, "true", r.URL.Query().Get("returnAsJSON"))
+		w.Write([]byte(`{"sum":3}`))
+	}))
+
+	t.Run("enabled", func(t *testing.T) {
+		enabled = true
+
+		var result struct {
+			Sum int `json:"sum"`
+		}
+		require.NoError(t, client.ExecuteAdminScript(context.Background(), "return {sum: 1 + 2};", &result))
+		require.Equal(t, 3, result.Sum)
+		require.Equal(t, "return {sum: 1 + 2};", script)
+		require.Equal(t, connection.PlainText, contentType)
+
+		require.NoError(t, client.ExecuteAdminScript(context.Background(), "return 1;", nil))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		enabled = false
+
+		err := client.ExecuteAdminScript(context.Background(), "return 1;", nil)
+		require.True(t, IsAdminScriptExecutionDisabled(err))
+		require.True(t, shared.IsNotFound(err))
+	})
+}
@@ -23,6 +23,8 @@ package arangodb
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -45,6 +47,85 @@ type LogLevelsSetOptions struct {
 	ServerID ServerID
 }
 
+// LogEntriesSort describes the order of the log entries.
+type LogEntriesSort string
+
+const (
+	LogEntriesSortAsc  LogEntriesSort = "asc"
+	LogEntriesSortDesc LogEntriesSort = "desc"
+)
+
+// LogEntriesOptions describes log entries read options.
+type LogEntriesOptions struct {
+	// Upto returns the entries up to the given level, e.g. "warning" returns the fatal, error and warning entries.
+	// It can not be used together with Level.
+	Upto string
+	// Level returns the entries of the given level only. It can not be used together with Upto.
+	Level string
+	// Start returns the entries with the ID greater or equal to the given one.
+	Start *int
+	// Size limits the number of returned entries.
+	Size *int
+	// Offset skips the given number of entries, it can be used together with Size for paging.
+	Offset *int
+	// Search returns the entries which message contains the given text.
+	Search string
+	// Sort sorts the entries by their ID.
+	Sort LogEntriesSort
+	// ServerID describes log entries for a specific server ID.
+	ServerID ServerID
+}
+
+func (o *LogEntriesOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Upto != "" {
+		r.AddQuery("upto", o.Upto)
+	}
+	if o.Level != "" {
+		r.AddQuery("level", o.Level)
+	}
+	if o.Start != nil {
+		r.AddQuery("start", strconv.Itoa(*o.Start))
+	}
+	if o.Size != nil {
+		r.AddQuery("size", strconv.Itoa(*o.Size))
+	}
+	if o.Offset != nil {
+		r.AddQuery("offset", strconv.Itoa(*o.Offset))
+	}
+	if o.Search != "" {
+		r.AddQuery("search", o.Search)
+	}
+	if o.Sort != "" {
+		r.AddQuery("sort", string(o.Sort))
+	}
+	if len(o.ServerID) > 0 {
+		r.AddQuery("serverId", string(o.ServerID))
+	}
+
+	return nil
+}
+
+// LogEntries contains the log entries of the server.
+type LogEntries struct {
+	// Total is the number of the entries matching the options before Size and Offset are applied.
+	Total int `json:"total"`
+	// Messages contains the log entries.
+	Messages []LogEntry `json:"messages"`
+}
+
+// LogEntry is a single entry of the server log.
+type LogEntry struct {
+	ID      int       `json:"id"`
+	Topic   string    `json:"topic"`
+	Level   string    `json:"level"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message"`
+}
+
 // GetLogLevels returns log levels for topics.
 func (c *clientAdmin) GetLogLevels(ctx context.Context, opts *LogLevelsGetOptions) (LogLevels, error) {
 	url := connection.NewUrl("_admin", "log", "level")
@@ -98,3 +179,25 @@ func (c *clientAdmin) SetLogLevels(ctx context.Context, logLevels LogLevels, opt
 		return response.AsArangoErrorWithCode(code)
 	}
 }
+
+// ReadLogEntries returns the log entries of the server matching the given options.
+func (c *clientAdmin) ReadLogEntries(ctx context.Context, opts *LogEntriesOptions) (LogEntries, error) {
+	url := connection.NewUrl("_admin", "log", "entries")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		LogEntries
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response, opts.modifyRequest)
+	if err != nil {
+		return LogEntries{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.LogEntries, nil
+	default:
+		return LogEntries{}, response.AsArangoErrorWithCode(code)
+	}
+}
@@ -0,0 +1,68 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+type ClientAdminMetrics interface {
+	// Metrics returns the metrics of the server in the Prometheus text format.
+	// When serverID is set, the request is forwarded to the given server of the cluster.
+	// This call needs ArangoDB 3.8 and up.
+	Metrics(ctx context.Context, serverID *string) (Metrics, error)
+}
+
+// Metrics contains metrics of the server returned by the endpoint `/_admin/metrics/v2`.
+type Metrics struct {
+	// Raw is the response in the Prometheus text exposition format.
+	Raw string
+}
+
+// MetricType is a type of the metric family.
+type MetricType string
+
+const (
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeHistogram MetricType = "histogram"
+	MetricTypeSummary   MetricType = "summary"
+	MetricTypeUntyped   MetricType = "untyped"
+)
+
+// MetricFamily is a group of samples which share the same metric name.
+// For histograms and summaries, the samples with suffixes `_bucket`, `_sum` and `_count` belong to the family too.
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []MetricSample
+}
+
+// MetricSample is a single value of the metric.
+type MetricSample struct {
+	// Name of the sample, e.g. `arangodb_request_body_size_bucket` for histogram buckets.
+	Name   string
+	Labels map[string]string
+	Value  float64
+	// Timestamp in milliseconds, it is optional.
+	Timestamp *int64
+}
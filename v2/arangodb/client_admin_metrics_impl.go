@@ -0,0 +1,234 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// Metrics returns the metrics of the server in the Prometheus text format.
+func (c *clientAdmin) Metrics(ctx context.Context, serverID *string) (Metrics, error) {
+	url := connection.NewUrl("_admin", "metrics", "v2")
+
+	var mods []connection.RequestModifier
+	if serverID != nil {
+		mods = append(mods, connection.WithQuery("serverId", *serverID))
+	}
+
+	var raw []byte
+	resp, err := connection.CallWithChecks(ctx, c.client.connection, http.MethodGet, url, &raw, []int{http.StatusOK}, mods...)
+	if err != nil {
+		return Metrics{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return Metrics{Raw: string(raw)}, nil
+	default:
+		var r *shared.ResponseStruct // nil
+		return Metrics{}, r.AsArangoErrorWithCode(code)
+	}
+}
+
+// Families parses the raw metrics and returns them grouped by the name of the metric family.
+func (m Metrics) Families() (map[string]MetricFamily, error) {
+	families := map[string]MetricFamily{}
+
+	scanner := bufio.NewScanner(strings.NewReader(m.Raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "#") {
+			parseMetricComment(families, text)
+			continue
+		}
+
+		sample, err := parseMetricSample(text)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid metric sample in line %d", line)
+		}
+
+		name := metricFamilyName(families, sample.Name)
+		family, ok := families[name]
+		if !ok {
+			family = MetricFamily{Name: name, Type: MetricTypeUntyped}
+		}
+		family.Samples = append(family.Samples, sample)
+		families[name] = family
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return families, nil
+}
+
+// parseMetricComment handles the `# HELP` and `# TYPE` lines. Other comments are ignored.
+func parseMetricComment(families map[string]MetricFamily, text string) {
+	fields := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(text, "#")), " ", 3)
+	if len(fields) < 3 {
+		return
+	}
+
+	family, ok := families[fields[1]]
+	if !ok {
+		family = MetricFamily{Name: fields[1], Type: MetricTypeUntyped}
+	}
+
+	switch fields[0] {
+	case "HELP":
+		family.Help = strings.NewReplacer(`\\`, `\`, `\n`, "\n").Replace(fields[2])
+	case "TYPE":
+		family.Type = MetricType(strings.TrimSpace(fields[2]))
+	default:
+		return
+	}
+
+	families[fields[1]] = family
+}
+
+// metricFamilyName returns the name of the family which the sample belongs to.
+func metricFamilyName(families map[string]MetricFamily, sampleName string) string {
+	if _, ok := families[sampleName]; ok {
+		return sampleName
+	}
+
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		base := strings.TrimSuffix(sampleName, suffix)
+		if base == sampleName {
+			continue
+		}
+
+		if family, ok := families[base]; ok && (family.Type == MetricTypeHistogram || family.Type == MetricTypeSummary) {
+			return base
+		}
+	}
+
+	return sampleName
+}
+
+// parseMetricSample parses a line in the format: `name{label="value",...} value [timestamp]`.
+func parseMetricSample(text string) (MetricSample, error) {
+	var sample MetricSample
+
+	nameEnd := strings.IndexAny(text, "{ \t")
+	if nameEnd <= 0 {
+		return sample, errors.Errorf("missing value")
+	}
+	sample.Name = text[:nameEnd]
+	rest := text[nameEnd:]
+
+	if strings.HasPrefix(rest, "{") {
+		labels, n, err := parseMetricLabels(rest)
+		if err != nil {
+			return sample, err
+		}
+		sample.Labels = labels
+		rest = rest[n:]
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample, errors.Errorf("expected value and optional timestamp, got '%s'", rest)
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample, errors.WithStack(err)
+	}
+	sample.Value = value
+
+	if len(fields) == 2 {
+		timestamp, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return sample, errors.WithStack(err)
+		}
+		sample.Timestamp = &timestamp
+	}
+
+	return sample, nil
+}
+
+// parseMetricLabels parses labels enclosed in curly braces.
+// It returns the labels and the number of consumed bytes.
+func parseMetricLabels(text string) (map[string]string, int, error) {
+	labels := map[string]string{}
+
+	i := 1 // Skip '{'.
+	for {
+		for i < len(text) && (text[i] == ' ' || text[i] == ',') {
+			i++
+		}
+		if i >= len(text) {
+			return nil, 0, errors.Errorf("labels are not closed")
+		}
+		if text[i] == '}' {
+			return labels, i + 1, nil
+		}
+
+		eq := strings.IndexByte(text[i:], '=')
+		if eq < 0 {
+			return nil, 0, errors.Errorf("missing '=' in labels")
+		}
+		name := strings.TrimSpace(text[i : i+eq])
+		i += eq + 1
+
+		if i >= len(text) || text[i] != '"' {
+			return nil, 0, errors.Errorf("value of the label '%s' is not quoted", name)
+		}
+		i++
+
+		var value strings.Builder
+		for ; i < len(text) && text[i] != '"'; i++ {
+			if text[i] == '\\' && i+1 < len(text) {
+				i++
+				switch text[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(text[i])
+				}
+				continue
+			}
+			value.WriteByte(text[i])
+		}
+		if i >= len(text) {
+			return nil, 0, errors.Errorf("value of the label '%s' is not closed", name)
+		}
+		i++ // Skip '"'.
+
+		labels[name] = value.String()
+	}
+}
@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_Families(t *testing.T) {
+	raw := `# HELP arangodb_client_connection_statistics_total_time Total time needed to answer a request
+# TYPE arangodb_client_connection_statistics_total_time histogram
+arangodb_client_connection_statistics_total_time_bucket{role="SINGLE",le="0.01"} 3
+arangodb_client_connection_statistics_total_time_bucket{role="SINGLE",le="+Inf"} 5
+arangodb_client_connection_statistics_total_time_sum{role="SINGLE"} 0.25
+arangodb_client_connection_statistics_total_time_count{role="SINGLE"} 5
+# HELP arangodb_process_statistics_resident_set_size Resident set size\nin bytes
+# TYPE arangodb_process_statistics_resident_set_size gauge
+arangodb_process_statistics_resident_set_size{role="SINGLE"} 1.2e+08 1700000000000
+# just a comment
+untyped_metric NaN
+labels_with_escapes{path="C:\\data",msg="say \"hi\"",} 1
+`
+
+	families, err := Metrics{Raw: raw}.Families()
+	require.NoError(t, err)
+	require.Len(t, families, 4)
+
+	histogram := families["arangodb_client_connection_statistics_total_time"]
+	assert.Equal(t, MetricTypeHistogram, histogram.Type)
+	assert.Equal(t, "Total time needed to answer a request", histogram.Help)
+	require.Len(t, histogram.Samples, 4)
+	assert.Equal(t, "arangodb_client_connection_statistics_total_time_bucket", histogram.Samples[1].Name)
+	assert.Equal(t, map[string]string{"role": "SINGLE", "le": "+Inf"}, histogram.Samples[1].Labels)
+	assert.Equal(t, 5.0, histogram.Samples[1].Value)
+	assert.Equal(t, 0.25, histogram.Samples[2].Value)
+
+	gauge := families["arangodb_process_statistics_resident_set_size"]
+	assert.Equal(t, MetricTypeGauge, gauge.Type)
+	assert.Equal(t, "Resident set size\nin bytes", gauge.Help)
+	require.Len(t, gauge.Samples, 1)
+	assert.Equal(t, 1.2e+08, gauge.Samples[0].Value)
+	require.NotNil(t, gauge.Samples[0].Timestamp)
+	assert.Equal(t, int64(1700000000000), *gauge.Samples[0].Timestamp)
+
+	untyped := families["untyped_metric"]
+	assert.Equal(t, MetricTypeUntyped, untyped.Type)
+	require.Len(t, untyped.Samples, 1)
+	assert.True(t, math.IsNaN(untyped.Samples[0].Value))
+	assert.Nil(t, untyped.Samples[0].Labels)
+
+	escaped := families["labels_with_escapes"]
+	require.Len(t, escaped.Samples, 1)
+	assert.Equal(t, map[string]string{"path": `C:\data`, "msg": `say "hi"`}, escaped.Samples[0].Labels)
+}
+
+func TestMetrics_FamiliesInvalid(t *testing.T) {
+	tests := map[string]string{
+		"missing value":       "metric_name",
+		"invalid value":       "metric_name abc",
+		"not closed labels":   `metric_name{a="b" 1`,
+		"not quoted label":    `metric_name{a=b} 1`,
+		"invalid timestamp":   "metric_name 1 abc",
+		"too many fields":     "metric_name 1 2 3",
+		"not closed value":    `metric_name{a="b} 1`,
+		"missing label value": `metric_name{a} 1`,
+	}
+
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Metrics{Raw: raw}.Families()
+			require.Error(t, err)
+		})
+	}
+}
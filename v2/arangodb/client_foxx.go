@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// ClientFoxx manages Foxx microservices.
+// https://docs.arangodb.com/stable/develop/http-api/foxx/
+type ClientFoxx interface {
+	// InstallService installs a new service at the given mount path of the database.
+	// The zipData is the service bundle in the zip format, it is streamed to the server.
+	InstallService(ctx context.Context, db, mount string, zipData io.Reader, opts *FoxxInstallOptions) (FoxxServiceInfo, error)
+
+	// ReplaceService replaces the service at the given mount path of the database with the new service bundle.
+	ReplaceService(ctx context.Context, db, mount string, zipData io.Reader, opts *FoxxReplaceOptions) (FoxxServiceInfo, error)
+
+	// UninstallService removes the service at the given mount path of the database.
+	// The teardown script of the service is executed.
+	UninstallService(ctx context.Context, db, mount string) error
+
+	// ListServices returns the services installed in the database, except system services.
+	ListServices(ctx context.Context, db string) ([]FoxxServiceInfo, error)
+}
+
+// FoxxServiceInfo contains the metadata of a Foxx service.
+type FoxxServiceInfo struct {
+	// Mount is the mount path of the service.
+	Mount string `json:"mount"`
+
+	// Name of the service.
+	Name string `json:"name,omitempty"`
+
+	// Version of the service.
+	Version string `json:"version,omitempty"`
+
+	// Development is true when the service is running in the development mode.
+	Development bool `json:"development"`
+
+	// Legacy is true when the service is running in the legacy compatibility mode.
+	Legacy bool `json:"legacy"`
+
+	// Provides contains the service dependencies which the service provides.
+	Provides map[string]interface{} `json:"provides,omitempty"`
+}
+
+type FoxxInstallOptions struct {
+	// Development enables the development mode of the service.
+	Development *bool
+
+	// Setup when set to false, the setup script of the service is not executed.
+	Setup *bool
+
+	// Legacy installs the service in the legacy compatibility mode.
+	Legacy *bool
+}
+
+func (f *FoxxInstallOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Development != nil {
+		r.AddQuery("development", boolToString(*f.Development))
+	}
+
+	if f.Setup != nil {
+		r.AddQuery("setup", boolToString(*f.Setup))
+	}
+
+	if f.Legacy != nil {
+		r.AddQuery("legacy", boolToString(*f.Legacy))
+	}
+
+	return nil
+}
+
+type FoxxReplaceOptions struct {
+	// Teardown when set to false, the teardown script of the old service is not executed.
+	Teardown *bool
+
+	// Setup when set to false, the setup script of the new service is not executed.
+	Setup *bool
+
+	// Legacy installs the new service in the legacy compatibility mode.
+	Legacy *bool
+
+	// Force when set to true, the service is installed even if no service is installed at the given mount path.
+	Force *bool
+}
+
+func (f *FoxxReplaceOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Teardown != nil {
+		r.AddQuery("teardown", boolToString(*f.Teardown))
+	}
+
+	if f.Setup != nil {
+		r.AddQuery("setup", boolToString(*f.Setup))
+	}
+
+	if f.Legacy != nil {
+		r.AddQuery("legacy", boolToString(*f.Legacy))
+	}
+
+	if f.Force != nil {
+		r.AddQuery("force", boolToString(*f.Force))
+	}
+
+	return nil
+}
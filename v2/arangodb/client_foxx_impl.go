@@ -0,0 +1,129 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+var _ ClientFoxx = &clientFoxx{}
+
+type clientFoxx struct {
+	client *client
+}
+
+func newClientFoxx(client *client) *clientFoxx {
+	return &clientFoxx{
+		client: client,
+	}
+}
+
+func (c *clientFoxx) InstallService(ctx context.Context, db, mount string, zipData io.Reader,
+	opts *FoxxInstallOptions) (FoxxServiceInfo, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxServiceInfo
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, c.url(db), &response, zipData,
+		withZipContent, connection.WithQuery("mount", mount), opts.modifyRequest)
+	if err != nil {
+		return FoxxServiceInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		return response.FoxxServiceInfo, nil
+	default:
+		return FoxxServiceInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientFoxx) ReplaceService(ctx context.Context, db, mount string, zipData io.Reader,
+	opts *FoxxReplaceOptions) (FoxxServiceInfo, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxServiceInfo
+	}
+
+	resp, err := connection.CallPut(ctx, c.client.connection, c.url(db, "service"), &response, zipData,
+		withZipContent, connection.WithQuery("mount", mount), opts.modifyRequest)
+	if err != nil {
+		return FoxxServiceInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FoxxServiceInfo, nil
+	default:
+		return FoxxServiceInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientFoxx) UninstallService(ctx context.Context, db, mount string) error {
+	var response shared.ResponseStruct
+
+	resp, err := connection.CallDelete(ctx, c.client.connection, c.url(db, "service"), &response,
+		connection.WithQuery("mount", mount))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientFoxx) ListServices(ctx context.Context, db string) ([]FoxxServiceInfo, error) {
+	var result []FoxxServiceInfo
+
+	resp, err := connection.CallGet(ctx, c.client.connection, c.url(db), &result)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return result, nil
+	default:
+		return nil, shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientFoxx) url(db string, parts ...string) string {
+	return connection.NewUrl(append([]string{"_db", db, "_api", "foxx"}, parts...)...)
+}
+
+// withZipContent sets the content type of the request body to the zip archive.
+func withZipContent(r connection.Request) error {
+	r.AddHeader(connection.ContentType, connection.ApplicationZip)
+	return nil
+}
@@ -38,6 +38,8 @@ func newClient(connection connection.Connection) *client {
 	c.clientServerInfo = newClientServerInfo(c)
 	c.clientAdmin = newClientAdmin(c)
 	c.clientAsyncJob = newClientAsyncJob(c)
+	c.clientFoxx = newClientFoxx(c)
+	c.clientReplication = newClientReplication(c)
 
 	c.Requests = NewRequests(connection)
 
@@ -54,6 +56,8 @@ type client struct {
 	*clientServerInfo
 	*clientAdmin
 	*clientAsyncJob
+	*clientFoxx
+	*clientReplication
 
 	Requests
 }
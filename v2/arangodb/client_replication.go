@@ -0,0 +1,94 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type ClientReplication interface {
+	// TailWAL follows the write-ahead log of the given database and sends its operations to the returned channel.
+	// It polls the server for new operations until the context is canceled, then both channels are closed.
+	// When a request fails, the error is sent to the error channel and tailing stops.
+	// It works only against a single server or a DB-Server, Coordinators do not provide the write-ahead log.
+	TailWAL(ctx context.Context, dbName string, opts *WALTailOptions) (<-chan WALEvent, <-chan error)
+}
+
+// WALTailOptions describes the options of tailing the write-ahead log.
+type WALTailOptions struct {
+	// From is the tick after which the operations are returned.
+	// When it is empty, only the operations made after the call are returned.
+	From string
+
+	// ChunkSize is the approximate maximum size of the returned data in one request in bytes.
+	ChunkSize *int
+
+	// PollInterval is the time to wait before asking the server again, when there are no new operations.
+	// Default: 1 second.
+	PollInterval time.Duration
+}
+
+// WALOperationType is the type of the operation in the write-ahead log.
+type WALOperationType int
+
+const (
+	WALOperationCollectionCreate   WALOperationType = 2000
+	WALOperationCollectionDrop     WALOperationType = 2001
+	WALOperationCollectionRename   WALOperationType = 2002
+	WALOperationCollectionChange   WALOperationType = 2003
+	WALOperationCollectionTruncate WALOperationType = 2004
+	WALOperationIndexCreate        WALOperationType = 2100
+	WALOperationIndexDrop          WALOperationType = 2101
+	WALOperationViewCreate         WALOperationType = 2110
+	WALOperationViewDrop           WALOperationType = 2111
+	WALOperationViewChange         WALOperationType = 2112
+	WALOperationTransactionStart   WALOperationType = 2200
+	WALOperationTransactionCommit  WALOperationType = 2201
+	WALOperationTransactionAbort   WALOperationType = 2202
+
+	// WALOperationDocument is an insert, update or replace of a document.
+	// The write-ahead log does not distinguish between them, the data contains the whole new document.
+	WALOperationDocument WALOperationType = 2300
+	// WALOperationRemove is a removal of a document. The data contains the key and the revision of the document.
+	WALOperationRemove WALOperationType = 2302
+)
+
+// WALEvent is a single operation from the write-ahead log.
+type WALEvent struct {
+	// Tick of the operation.
+	Tick string `json:"tick"`
+	// Type of the operation.
+	Type WALOperationType `json:"type"`
+	// Database is the ID of the database.
+	Database string `json:"db,omitempty"`
+	// CollectionGUID is the globally unique ID of the collection.
+	CollectionGUID string `json:"cuid,omitempty"`
+	// Collection is the name of the collection, it is empty for operations not related to a collection.
+	Collection string `json:"-"`
+	// TransactionID is the ID of the transaction which the operation belongs to.
+	TransactionID string `json:"tid,omitempty"`
+	// Key of the document for WALOperationDocument and WALOperationRemove operations.
+	Key string `json:"-"`
+	// Data of the operation, e.g. the document.
+	Data json.RawMessage `json:"data,omitempty"`
+}
@@ -0,0 +1,288 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+var _ ClientReplication = &clientReplication{}
+
+type clientReplication struct {
+	client *client
+}
+
+func newClientReplication(client *client) *clientReplication {
+	return &clientReplication{
+		client: client,
+	}
+}
+
+// walTailDefaultPollInterval is the default time to wait when there are no new operations in the write-ahead log.
+const walTailDefaultPollInterval = time.Second
+
+func (c *clientReplication) TailWAL(ctx context.Context, dbName string, opts *WALTailOptions) (<-chan WALEvent, <-chan error) {
+	events := make(chan WALEvent)
+	errs := make(chan error, 1)
+
+	var options WALTailOptions
+	if opts != nil {
+		options = *opts
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = walTailDefaultPollInterval
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		if err := c.tailWAL(ctx, dbName, options, events); err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+// tailWAL follows the write-ahead log until the context is canceled or an error occurs.
+func (c *clientReplication) tailWAL(ctx context.Context, dbName string, opts WALTailOptions, events chan<- WALEvent) error {
+	from := opts.From
+	if from == "" {
+		tick, err := c.lastLogTick(ctx, dbName)
+		if err != nil {
+			return err
+		}
+		from = tick
+	}
+
+	collections := map[string]string{}
+	lastScanned := "0"
+	for {
+		result, err := c.walTail(ctx, dbName, from, lastScanned, opts.ChunkSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range result.events {
+			if event.CollectionGUID != "" {
+				name, ok := collections[event.CollectionGUID]
+				if !ok {
+					// The collection is new, so the names must be fetched again.
+					if collections, err = c.collectionNames(ctx, dbName); err != nil {
+						return err
+					}
+					// The collection may have been dropped already, do not ask for it again.
+					name = collections[event.CollectionGUID]
+					collections[event.CollectionGUID] = name
+				}
+				event.Collection = name
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if result.lastIncluded != "" && result.lastIncluded != "0" {
+			from = result.lastIncluded
+		}
+		if result.lastScanned != "" {
+			lastScanned = result.lastScanned
+		}
+
+		if result.checkMore {
+			continue
+		}
+
+		select {
+		case <-time.After(opts.PollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// walTailResult is a single chunk of the write-ahead log.
+type walTailResult struct {
+	events       []WALEvent
+	lastIncluded string
+	lastScanned  string
+	checkMore    bool
+}
+
+// walTail fetches the operations from the write-ahead log after the given tick.
+func (c *clientReplication) walTail(ctx context.Context, dbName, from, lastScanned string, chunkSize *int) (walTailResult, error) {
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(dbName), "_api", "wal", "tail")
+
+	mods := []connection.RequestModifier{
+		connection.WithQuery("from", from),
+		connection.WithQuery("lastScanned", lastScanned),
+		withAcceptJSON,
+	}
+	if chunkSize != nil {
+		mods = append(mods, connection.WithQuery("chunkSize", strconv.Itoa(*chunkSize)))
+	}
+
+	resp, body, err := connection.CallStream(ctx, c.client.connection, http.MethodGet, urlEndpoint, mods...)
+	if err != nil {
+		return walTailResult{}, errors.WithStack(err)
+	}
+	if body != nil {
+		defer body.Close()
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusNoContent:
+		// Fallthrough.
+	default:
+		var response shared.ResponseStruct
+		if body != nil {
+			_ = json.NewDecoder(body).Decode(&response)
+		}
+		return walTailResult{}, response.AsArangoErrorWithCode(code)
+	}
+
+	result := walTailResult{
+		lastIncluded: resp.Header("x-arango-replication-lastincluded"),
+		lastScanned:  resp.Header("x-arango-replication-lastscanned"),
+		checkMore:    resp.Header("x-arango-replication-checkmore") == "true",
+	}
+
+	if body == nil {
+		return result, nil
+	}
+
+	result.events, err = parseWALEvents(body)
+	if err != nil {
+		return walTailResult{}, err
+	}
+
+	return result, nil
+}
+
+// parseWALEvents decodes the newline-delimited JSON operations of the write-ahead log.
+func parseWALEvents(r io.Reader) ([]WALEvent, error) {
+	var events []WALEvent
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(strings.TrimSpace(string(line))) > 0 {
+			var event WALEvent
+			if errJSON := json.Unmarshal(line, &event); errJSON != nil {
+				return nil, errors.WithStack(errJSON)
+			}
+
+			if event.Type == WALOperationDocument || event.Type == WALOperationRemove {
+				var document struct {
+					Key string `json:"_key"`
+				}
+				if errJSON := json.Unmarshal(event.Data, &document); errJSON == nil {
+					event.Key = document.Key
+				}
+			}
+
+			events = append(events, event)
+		}
+
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+}
+
+// lastLogTick returns the last tick of the write-ahead log.
+func (c *clientReplication) lastLogTick(ctx context.Context, dbName string) (string, error) {
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(dbName), "_api", "replication", "logger-state")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		State                 struct {
+			LastLogTick string `json:"lastLogTick"`
+		} `json:"state"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.State.LastLogTick, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+// collectionNames returns the names of the collections in the database by their globally unique IDs.
+func (c *clientReplication) collectionNames(ctx context.Context, dbName string) (map[string]string, error) {
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(dbName), "_api", "collection")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                []struct {
+			Name             string `json:"name"`
+			GloballyUniqueID string `json:"globallyUniqueId"`
+		} `json:"result"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		names := make(map[string]string, len(response.Result))
+		for _, col := range response.Result {
+			names[col.GloballyUniqueID] = col.Name
+		}
+		return names, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// withAcceptJSON asks the server to return the newline-delimited JSON.
+func withAcceptJSON(r connection.Request) error {
+	r.AddHeader("Accept", connection.ApplicationJSON)
+	return nil
+}
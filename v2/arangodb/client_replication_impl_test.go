@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseWALEvents(t *testing.T) {
+	body := `{"tick":"100","type":2200,"db":"db1","tid":"55"}
+{"tick":"101","type":2300,"db":"db1","cuid":"h1","tid":"55","data":{"_key":"k1","_id":"c/k1","_rev":"_a","name":"n"}}
+{"tick":"102","type":2201,"db":"db1","tid":"55"}
+
+{"tick":"103","type":2302,"db":"db1","cuid":"h1","tid":"0","data":{"_key":"k1","_rev":"_b"}}`
+
+	events, err := parseWALEvents(strings.NewReader(body))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	assert.Equal(t, WALOperationTransactionStart, events[0].Type)
+	assert.Empty(t, events[0].Key)
+
+	assert.Equal(t, "101", events[1].Tick)
+	assert.Equal(t, WALOperationDocument, events[1].Type)
+	assert.Equal(t, "db1", events[1].Database)
+	assert.Equal(t, "h1", events[1].CollectionGUID)
+	assert.Equal(t, "55", events[1].TransactionID)
+	assert.Equal(t, "k1", events[1].Key)
+	assert.JSONEq(t, `{"_key":"k1","_id":"c/k1","_rev":"_a","name":"n"}`, string(events[1].Data))
+
+	assert.Equal(t, WALOperationRemove, events[3].Type)
+	assert.Equal(t, "k1", events[3].Key)
+
+	events, err = parseWALEvents(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	_, err = parseWALEvents(strings.NewReader("{invalid\n"))
+	require.Error(t, err)
+}
@@ -25,6 +25,9 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 type ClientServerInfo interface {
@@ -40,6 +43,74 @@ type ClientServerInfo interface {
 	// ServerID Gets the ID of this server in the cluster.
 	// An error is returned when calling this to a server that is not part of a cluster.
 	ServerID(ctx context.Context) (string, error)
+
+	// ServerBootInfo returns information which identifies the current run of the server answering the request.
+	// Compare it with a previous observation using ServerBootInfo.RestartedSince to detect a server restart,
+	// which invalidates all cursors and transactions created before.
+	ServerBootInfo(ctx context.Context) (ServerBootInfo, error)
+
+	// Ping checks if the server is reachable and accepts requests.
+	// When the server is reachable, but it is in the maintenance or read-only mode,
+	// a ServerUnavailableError is returned. Otherwise, the error of the connection is returned.
+	Ping(ctx context.Context) error
+}
+
+// ServerUnavailableError is returned when the server is reachable, but it does not accept all the requests.
+type ServerUnavailableError struct {
+	// Code is the HTTP status code of the availability check.
+	Code int
+	// Mode is the mode of the server, e.g. ServerModeReadOnly. It is empty when the server does not accept any requests.
+	Mode ServerMode
+}
+
+// Error implements the error interface.
+func (s ServerUnavailableError) Error() string {
+	if s.Mode != "" {
+		return fmt.Sprintf("server is available in the '%s' mode only", s.Mode)
+	}
+	return fmt.Sprintf("server is unavailable, code %d", s.Code)
+}
+
+// IsServerUnavailable returns true if the given error is a ServerUnavailableError.
+func IsServerUnavailable(err error) bool {
+	var e ServerUnavailableError
+	return errors.As(err, &e)
+}
+
+// bootTimeTolerance is the maximum difference between two computed boot times of the same server run.
+// The boot time is derived from the uptime, so it is affected by the request latency.
+const bootTimeTolerance = 5 * time.Second
+
+// ServerBootInfo describes the current run of a database server.
+type ServerBootInfo struct {
+	// Endpoint of the server which has been asked.
+	Endpoint string
+
+	// ServerID is the ID of the server in the cluster. It is empty for single servers.
+	ServerID string
+
+	// ProcessID is the process ID of the server.
+	ProcessID int64
+
+	// RebootID is increased by the cluster every time the server is restarted. It is 0 for single servers.
+	RebootID int64
+
+	// Uptime of the server.
+	Uptime time.Duration
+
+	// BootTime is the point in time (measured by the local clock) when the server has been started.
+	BootTime time.Time
+}
+
+// RestartedSince returns true when the server has been restarted since the previous observation.
+// Both observations must come from the same server.
+func (b ServerBootInfo) RestartedSince(previous ServerBootInfo) bool {
+	if b.ProcessID != previous.ProcessID || b.RebootID != previous.RebootID {
+		return true
+	}
+
+	diff := b.BootTime.Sub(previous.BootTime)
+	return diff > bootTimeTolerance || diff < -bootTimeTolerance
 }
 
 // VersionInfo describes the version of a database server.
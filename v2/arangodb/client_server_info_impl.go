@@ -23,6 +23,7 @@ package arangodb
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
 
@@ -148,6 +149,32 @@ func (c clientServerInfo) ServerRole(ctx context.Context) (ServerRole, error) {
 	return ServerRoleSingleActive, nil
 }
 
+func (c clientServerInfo) Ping(ctx context.Context) error {
+	url := connection.NewUrl("_admin", "server", "availability")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Mode                  ServerMode `json:"mode,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		if response.Mode != "" && response.Mode != ServerModeDefault {
+			return errors.WithStack(ServerUnavailableError{Code: code, Mode: response.Mode})
+		}
+		return nil
+	case http.StatusServiceUnavailable:
+		return errors.WithStack(ServerUnavailableError{Code: code})
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (c clientServerInfo) ServerID(ctx context.Context) (string, error) {
 	url := connection.NewUrl("_admin", "server", "id")
 
@@ -169,6 +196,65 @@ func (c clientServerInfo) ServerID(ctx context.Context) (string, error) {
 	}
 }
 
+func (c clientServerInfo) ServerBootInfo(ctx context.Context) (ServerBootInfo, error) {
+	url := connection.NewUrl("_admin", "status")
+
+	var status struct {
+		shared.ResponseStruct `json:",inline"`
+		PID                   int64 `json:"pid,omitempty"`
+		ServerInfo            struct {
+			ServerID string `json:"serverId,omitempty"`
+			RebootID int64  `json:"rebootId,omitempty"`
+		} `json:"serverInfo"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &status)
+	if err != nil {
+		return ServerBootInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// Fallthrough.
+	default:
+		return ServerBootInfo{}, status.AsArangoErrorWithCode(code)
+	}
+
+	// The uptime must be read from the same server which has returned the status.
+	req, err := c.client.connection.NewRequestWithEndpoint(resp.Endpoint(), http.MethodGet, connection.NewUrl("_admin", "statistics"))
+	if err != nil {
+		return ServerBootInfo{}, errors.WithStack(err)
+	}
+
+	var statistics struct {
+		shared.ResponseStruct `json:",inline"`
+		Server                struct {
+			Uptime float64 `json:"uptime,omitempty"`
+		} `json:"server"`
+	}
+
+	resp, err = c.client.connection.Do(ctx, req, &statistics)
+	if err != nil {
+		return ServerBootInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		uptime := time.Duration(statistics.Server.Uptime * float64(time.Second))
+
+		return ServerBootInfo{
+			Endpoint:  resp.Endpoint(),
+			ServerID:  status.ServerInfo.ServerID,
+			ProcessID: status.PID,
+			RebootID:  status.ServerInfo.RebootID,
+			Uptime:    uptime,
+			BootTime:  time.Now().Add(-uptime),
+		}, nil
+	default:
+		return ServerBootInfo{}, statistics.AsArangoErrorWithCode(code)
+	}
+}
+
 // echo returns what is sent to the server.
 func (c clientServerInfo) echo(ctx context.Context) error {
 	url := connection.NewUrl("_admin", "echo")
@@ -21,9 +21,13 @@
 package arangodb
 
 import (
+	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConvertServerRole(t *testing.T) {
@@ -73,3 +77,75 @@ func TestConvertServerRole(t *testing.T) {
 		})
 	}
 }
+
+func TestServerBootInfo_RestartedSince(t *testing.T) {
+	bootTime := time.Now().Add(-time.Hour)
+	previous := ServerBootInfo{
+		ProcessID: 1,
+		RebootID:  2,
+		Uptime:    time.Hour,
+		BootTime:  bootTime,
+	}
+
+	tests := map[string]struct {
+		current ServerBootInfo
+		want    bool
+	}{
+		"same run": {
+			current: ServerBootInfo{ProcessID: 1, RebootID: 2, Uptime: 2 * time.Hour, BootTime: bootTime.Add(time.Second)},
+		},
+		"different process": {
+			current: ServerBootInfo{ProcessID: 7, RebootID: 2, Uptime: 2 * time.Hour, BootTime: bootTime},
+			want:    true,
+		},
+		"different reboot ID": {
+			current: ServerBootInfo{ProcessID: 1, RebootID: 3, Uptime: 2 * time.Hour, BootTime: bootTime},
+			want:    true,
+		},
+		"same process ID but started later": {
+			current: ServerBootInfo{ProcessID: 1, RebootID: 2, Uptime: time.Minute, BootTime: bootTime.Add(time.Minute)},
+			want:    true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, test.want, test.current.RestartedSince(previous))
+		})
+	}
+}
+
+func TestClientServerInfo_Ping(t *testing.T) {
+	var code int
+	var body string
+	client := NewClient(newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_admin/server/availability", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		w.Write([]byte(body))
+	}))
+
+	t.Run("available", func(t *testing.T) {
+		code, body = http.StatusOK, `{"mode":"default"}`
+		require.NoError(t, client.Ping(context.Background()))
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		code, body = http.StatusOK, `{"mode":"readonly"}`
+
+		err := client.Ping(context.Background())
+		require.True(t, IsServerUnavailable(err))
+
+		var unavailable ServerUnavailableError
+		require.ErrorAs(t, err, &unavailable)
+		require.Equal(t, ServerModeReadOnly, unavailable.Mode)
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		code, body = http.StatusServiceUnavailable, `{"error":true,"code":503,"errorNum":503,"errorMessage":"service unavailable"}`
+
+		err := client.Ping(context.Background())
+		require.True(t, IsServerUnavailable(err))
+	})
+}
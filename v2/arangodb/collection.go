@@ -22,15 +22,33 @@ package arangodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 type Collection interface {
 	Name() string
 	Database() Database
 
+	// DocumentID returns the `_id` of the document with the given key in this collection,
+	// e.g. to reference it in the `_from` and `_to` attributes of an edge.
+	// It does not check if the document exists.
+	DocumentID(key string) string
+
 	// Shards fetches shards information of the collection.
 	Shards(ctx context.Context, details bool) (CollectionShards, error)
 
+	// ResponsibleShard returns the shard responsible for the given document.
+	// The document must contain all the shard key attributes of the collection.
+	// In single server deployments, the collection has only one shard named like the collection.
+	ResponsibleShard(ctx context.Context, document interface{}) (ShardID, error)
+
 	// Remove removes the entire collection.
 	// If the collection does not exist, a NotFoundError is returned.
 	Remove(ctx context.Context) error
@@ -48,9 +66,156 @@ type Collection interface {
 	// SetProperties allows modifying collection parameters
 	SetProperties(ctx context.Context, options SetCollectionPropertiesOptions) error
 
+	// Rename renames the collection and updates the name of this handle on success.
+	// Other handles of the collection keep the old name, so they can not be used anymore.
+	// It must not be called concurrently with other operations on this handle.
+	// Renaming is not supported in a cluster, the server's error is returned there.
+	Rename(ctx context.Context, newName string) error
+
+	// Unload unloads the collection from memory.
+	// Only the MMFiles storage engine supports it, an EngineNotSupportedError is returned for other engines.
+	Unload(ctx context.Context) error
+
 	// Count fetches the number of document in the collection.
 	Count(ctx context.Context) (int64, error)
 
+	// RecalculateCount forces the server to recompute the cached number of documents in the collection,
+	// e.g. after it has drifted due to a crash. The recalculated number of documents is returned.
+	RecalculateCount(ctx context.Context) (int64, error)
+
+	// RevisionAndCount returns the current revision and the number of documents of the collection, which are consistent
+	// with each other, e.g. to detect cheaply the changes in sync/ETL jobs.
+	// The server does not return both at once, so the revision is read before and after the count until it is unchanged.
+	// When the collection keeps changing, a CollectionChangingError is returned.
+	RevisionAndCount(ctx context.Context) (CollectionRevisionAndCount, error)
+
+	// Compact triggers the compaction of the collection's data, e.g. to reclaim the space after large deletes.
+	// The server's error is returned if the compaction is not supported.
+	Compact(ctx context.Context) error
+
+	// RevisionTreeSummary returns the summary of the revision tree (Merkle tree) of the collection,
+	// which is used by the revision-based replication (see CollectionProperties.SyncByRevision).
+	// Comparing the summaries of the shard on the leader and on its followers helps to diagnose the replication drift.
+	// It works only against a single server or a DB-Server, where the name of the shard must be used as the collection name.
+	// Coordinators do not serve the revision trees.
+	RevisionTreeSummary(ctx context.Context) (RevisionTreeSummary, error)
+
+	// DocumentRevisions returns the revisions of the document with the given key which are known to the revision tree
+	// of the collection, together with the documents stored for them. Only the revision range of the current revision
+	// of the document is listed, not the whole tree. An empty result means the tree does not know the document,
+	// which indicates a replication divergence, e.g. when the results of the leader and its followers differ.
+	// It works only against a single server or a DB-Server, where the name of the shard must be used as the collection name.
+	// Otherwise, a PreconditionFailed error is returned.
+	DocumentRevisions(ctx context.Context, key string) ([]map[string]interface{}, error)
+
+	// WaitForSync waits until all shards of the collection have all their followers in sync.
+	// It is not related to the waitForSync flag of the write operations.
+	// When the timeout elapses, a CollectionNotInSyncError with the lagging shards is returned.
+	// In single server deployments, it returns immediately.
+	WaitForSync(ctx context.Context, timeout time.Duration) error
+
 	CollectionDocuments
 	CollectionIndexes
+	CollectionGeo
+}
+
+// RevisionTreeSummary summarizes the revision tree of a collection.
+type RevisionTreeSummary struct {
+	// Version is the version of the format of the tree.
+	Version int `json:"version"`
+	// MaxDepth is the depth of the tree.
+	MaxDepth int `json:"maxDepth"`
+	// RangeMin is the lowest revision covered by the tree.
+	RangeMin string `json:"rangeMin"`
+	// RangeMax is the highest revision covered by the tree.
+	RangeMax string `json:"rangeMax"`
+	// InitialRangeMin is the lowest revision covered by the tree when it was created.
+	InitialRangeMin string `json:"initialRangeMin"`
+	// Count is the number of documents in the tree.
+	Count uint64 `json:"count"`
+	// Hash is the hash of all the revisions in the tree. It is equal for the in-sync replicas.
+	Hash uint64 `json:"hash"`
+	// Leaves is the number of the leaves of the tree.
+	Leaves int `json:"-"`
+	// PopulatedLeaves is the number of the leaves which contain at least one document.
+	PopulatedLeaves int `json:"-"`
+}
+
+// CollectionRevisionAndCount contains the revision and the number of documents of a collection at the same point in time.
+type CollectionRevisionAndCount struct {
+	// Revision is the revision of the collection. It changes whenever the documents of the collection change.
+	Revision string `json:"revision"`
+	// Count is the number of documents in the collection.
+	Count int64 `json:"count"`
+}
+
+// CollectionChangingError is returned when the revision and the count of the collection could not be read consistently,
+// because the collection kept changing.
+type CollectionChangingError struct {
+	// Collection is the name of the collection.
+	Collection string
+	// Attempts is the number of the attempts to read the revision and the count.
+	Attempts int
+}
+
+// Error implements the error interface.
+func (c CollectionChangingError) Error() string {
+	return fmt.Sprintf("collection '%s' kept changing during %d attempts to read its revision and count", c.Collection, c.Attempts)
+}
+
+// IsCollectionChanging returns true if the given error is a CollectionChangingError.
+func IsCollectionChanging(err error) bool {
+	var e CollectionChangingError
+	return errors.As(err, &e)
+}
+
+// CollectionNotInSyncError is returned when the shards of the collection are not in sync in the given time.
+type CollectionNotInSyncError struct {
+	// Collection is the name of the collection.
+	Collection string
+	// Shards contains the shards which followers are not in sync.
+	Shards []ShardID
+}
+
+// Error implements the error interface.
+func (c CollectionNotInSyncError) Error() string {
+	shards := make([]string, len(c.Shards))
+	for i, s := range c.Shards {
+		shards[i] = string(s)
+	}
+	sort.Strings(shards)
+
+	return fmt.Sprintf("collection '%s' is not in sync, lagging shards: %s", c.Collection, strings.Join(shards, ", "))
+}
+
+// IsCollectionNotInSync returns true if the given error is a CollectionNotInSyncError.
+func IsCollectionNotInSync(err error) bool {
+	var e CollectionNotInSyncError
+	return errors.As(err, &e)
+}
+
+// WriteConcernNotMetError is returned when a document write in a cluster could not be replicated
+// to enough in-sync followers to fulfill the write concern of the collection.
+// The original ArangoError is available via errors.Unwrap.
+type WriteConcernNotMetError struct {
+	// Collection is the name of the collection the write was sent to.
+	Collection string
+	// Err is the error returned by the server.
+	Err error
+}
+
+// Error implements the error interface.
+func (w WriteConcernNotMetError) Error() string {
+	return fmt.Sprintf("write concern not fulfilled for collection '%s': %s", w.Collection, w.Err)
+}
+
+// Unwrap returns the error returned by the server.
+func (w WriteConcernNotMetError) Unwrap() error {
+	return w.Err
+}
+
+// IsWriteConcernNotMet returns true if the given error indicates that the write concern of a write was not fulfilled.
+// Use errors.As with WriteConcernNotMetError to get the name of the affected collection.
+func IsWriteConcernNotMet(err error) bool {
+	return shared.IsWriteConcernNotMet(err)
 }
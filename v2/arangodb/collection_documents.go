@@ -28,9 +28,22 @@ type CollectionDocuments interface {
 	// DocumentExists checks if a document with given key exists in the collection.
 	DocumentExists(ctx context.Context, key string) (bool, error)
 
+	// DocumentExistsWithRevision checks if a document with given key exists in the collection.
+	// It also returns the current revision (`_rev`) of the document, taken from the ETag header of the response.
+	// The document itself is not transferred.
+	DocumentExistsWithRevision(ctx context.Context, key string) (bool, string, error)
+
+	// ExistingKeys returns the keys from the given list which documents exist in the collection.
+	// The keys are checked in batches, so the list can be large.
+	// The order of the returned keys is not defined and every key is returned at most once.
+	ExistingKeys(ctx context.Context, keys []string) ([]string, error)
+
 	CollectionDocumentCreate
 	CollectionDocumentRead
 	CollectionDocumentUpdate
 	CollectionDocumentReplace
 	CollectionDocumentDelete
+	CollectionDocumentUpsert
+	CollectionDocumentAll
+	CollectionDocumentImport
 }
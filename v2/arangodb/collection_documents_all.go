@@ -0,0 +1,63 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+)
+
+// CollectionDocumentAll iterates over all documents stored in a collection.
+type CollectionDocumentAll interface {
+	// All returns a reader which pages through all documents of the collection.
+	// Internally it runs the AQL `FOR d IN @@collection RETURN d` statement as a streaming query,
+	// so documents are fetched from the server in batches (see AllDocumentsOptions.BatchSize).
+	// The returned reader must always be closed, also when the caller stops reading early,
+	// so the server cursor is released.
+	All(ctx context.Context, opts *AllDocumentsOptions) (CollectionDocumentAllReader, error)
+}
+
+// CollectionDocumentAllReader yields documents of a collection one at a time.
+type CollectionDocumentAllReader interface {
+	io.Closer
+
+	// Read reads the next document into the result and returns its meta data.
+	// If there are no more documents, a NoMoreDocuments error is returned.
+	Read(result interface{}) (DocumentMeta, error)
+}
+
+type AllDocumentsOptions struct {
+	// BatchSize is the maximum number of documents fetched from the server in one round trip.
+	// The server default is used when it is not set.
+	BatchSize int
+
+	// KeysOnly when set to true, only the `_key`, `_id` and `_rev` attributes of the documents are returned.
+	KeysOnly bool
+
+	// Attributes limits the returned documents to the given top-level attributes.
+	// The `_key`, `_id` and `_rev` attributes are always returned.
+	// It is ignored when KeysOnly is set.
+	Attributes []string
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
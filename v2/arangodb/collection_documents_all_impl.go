@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func newCollectionDocumentAll(collection *collection) *collectionDocumentAll {
+	return &collectionDocumentAll{
+		collection: collection,
+	}
+}
+
+var _ CollectionDocumentAll = &collectionDocumentAll{}
+
+type collectionDocumentAll struct {
+	collection *collection
+}
+
+func (c collectionDocumentAll) All(ctx context.Context, opts *AllDocumentsOptions) (CollectionDocumentAllReader, error) {
+	query, bindVars := opts.query(c.collection.name)
+
+	queryOptions := &QueryOptions{
+		BindVars: bindVars,
+		Options: QuerySubOptions{
+			Stream: true,
+		},
+	}
+	if opts != nil {
+		queryOptions.BatchSize = opts.BatchSize
+		queryOptions.TransactionID = opts.TransactionID
+	}
+
+	cursor, err := c.collection.db.Query(ctx, query, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &collectionDocumentAllReader{
+		ctx:    ctx,
+		cursor: cursor,
+	}, nil
+}
+
+// query returns the AQL statement and its bind parameters which read all documents.
+func (o *AllDocumentsOptions) query(collection string) (string, map[string]interface{}) {
+	bindVars := map[string]interface{}{
+		"@collection": collection,
+	}
+
+	if o == nil {
+		return "FOR d IN @@collection RETURN d", bindVars
+	}
+
+	if o.KeysOnly {
+		return "FOR d IN @@collection RETURN KEEP(d, \"_key\", \"_id\", \"_rev\")", bindVars
+	}
+
+	if len(o.Attributes) > 0 {
+		bindVars["attributes"] = o.Attributes
+		return "FOR d IN @@collection RETURN KEEP(d, APPEND([\"_key\", \"_id\", \"_rev\"], @attributes))", bindVars
+	}
+
+	return "FOR d IN @@collection RETURN d", bindVars
+}
+
+var _ CollectionDocumentAllReader = &collectionDocumentAllReader{}
+
+type collectionDocumentAllReader struct {
+	lock sync.Mutex
+
+	ctx    context.Context
+	cursor Cursor
+
+	exhausted bool
+	closed    bool
+}
+
+func (r *collectionDocumentAllReader) Read(result interface{}) (DocumentMeta, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed || r.exhausted {
+		return DocumentMeta{}, shared.NoMoreDocumentsError{}
+	}
+
+	meta, err := r.cursor.ReadDocument(r.ctx, result)
+	if shared.IsNoMoreDocuments(err) {
+		r.exhausted = true
+	}
+
+	return meta, err
+}
+
+// Close releases the server cursor when not all documents have been read.
+// The server removes exhausted cursors on its own.
+func (r *collectionDocumentAllReader) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	if r.exhausted || !r.cursor.HasMore() {
+		return nil
+	}
+
+	return r.cursor.CloseWithContext(context.Background())
+}
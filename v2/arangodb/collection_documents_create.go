@@ -129,6 +129,8 @@ type CollectionDocumentCreateOptions struct {
 
 	// Additionally return the complete old document under the attribute.
 	// Only available if the overwrite option is used.
+	// When creating multiple documents, the `Old` field of the response is nil for the documents which have been inserted,
+	// and the object is reused, so it holds the old document of the last read response only.
 	OldObject interface{}
 
 	// RefillIndexCaches if set to true then refills the in-memory index caches.
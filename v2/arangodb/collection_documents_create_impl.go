@@ -22,6 +22,7 @@ package arangodb
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 
@@ -56,7 +57,7 @@ func (c collectionDocumentCreate) CreateDocumentsWithOptions(ctx context.Context
 		return nil, err
 	}
 
-	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, connection.WithBody(documents), connection.WithFragment("multiple")) {
+	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, withTransactionLimits(ctx), connection.WithBody(documents), connection.WithFragment("multiple")) {
 		if err = modifier(req); err != nil {
 			return nil, err
 		}
@@ -73,7 +74,7 @@ func (c collectionDocumentCreate) CreateDocumentsWithOptions(ctx context.Context
 	case http.StatusCreated:
 		fallthrough
 	case http.StatusAccepted:
-		return newCollectionDocumentCreateResponseReader(&arr, opts), nil
+		return newCollectionDocumentCreateResponseReader(&arr, opts, c.collection.name), nil
 	default:
 		return nil, shared.NewResponseStruct().AsArangoErrorWithCode(code)
 	}
@@ -106,7 +107,7 @@ func (c collectionDocumentCreate) CreateDocumentWithOptions(ctx context.Context,
 		New: newUnmarshalInto(meta.New),
 	}
 
-	resp, err := connection.CallPost(ctx, c.collection.connection(), url, &response, document, c.collection.withModifiers(options.modifyRequest)...)
+	resp, err := connection.CallPost(ctx, c.collection.connection(), url, &response, document, c.collection.withModifiers(options.modifyRequest, withTransactionLimits(ctx))...)
 	if err != nil {
 		return CollectionDocumentCreateResponse{}, err
 	}
@@ -117,7 +118,7 @@ func (c collectionDocumentCreate) CreateDocumentWithOptions(ctx context.Context,
 	case http.StatusAccepted:
 		return meta, nil
 	default:
-		return CollectionDocumentCreateResponse{}, response.AsArangoErrorWithCode(code)
+		return CollectionDocumentCreateResponse{}, withWriteConcernError(c.collection.name, response.AsArangoErrorWithCode(code))
 	}
 }
 
@@ -125,27 +126,21 @@ func (c collectionDocumentCreate) CreateDocument(ctx context.Context, document i
 	return c.CreateDocumentWithOptions(ctx, document, nil)
 }
 
-func newCollectionDocumentCreateResponseReader(array *connection.Array, options *CollectionDocumentCreateOptions) *collectionDocumentCreateResponseReader {
-	c := &collectionDocumentCreateResponseReader{array: array, options: options}
-
-	if c.options != nil {
-		c.response.Old = newUnmarshalInto(c.options.OldObject)
-		c.response.New = newUnmarshalInto(c.options.NewObject)
-	}
-
-	return c
+func newCollectionDocumentCreateResponseReader(array *connection.Array, options *CollectionDocumentCreateOptions, col string) *collectionDocumentCreateResponseReader {
+	return &collectionDocumentCreateResponseReader{array: array, options: options, collection: col}
 }
 
 var _ CollectionDocumentCreateResponseReader = &collectionDocumentCreateResponseReader{}
 
 type collectionDocumentCreateResponseReader struct {
-	array    *connection.Array
-	options  *CollectionDocumentCreateOptions
-	response struct {
+	array      *connection.Array
+	options    *CollectionDocumentCreateOptions
+	collection string
+	response   struct {
 		*DocumentMeta
 		*shared.ResponseStruct `json:",inline"`
-		Old                    *UnmarshalInto `json:"old,omitempty"`
-		New                    *UnmarshalInto `json:"new,omitempty"`
+		Old                    json.RawMessage `json:"old,omitempty"`
+		New                    json.RawMessage `json:"new,omitempty"`
 	}
 }
 
@@ -163,6 +158,8 @@ func (c *collectionDocumentCreateResponseReader) Read() (CollectionDocumentCreat
 
 	c.response.DocumentMeta = &meta.DocumentMeta
 	c.response.ResponseStruct = &meta.ResponseStruct
+	c.response.Old = nil
+	c.response.New = nil
 
 	if err := c.array.Unmarshal(&c.response); err != nil {
 		if err == io.EOF {
@@ -171,9 +168,35 @@ func (c *collectionDocumentCreateResponseReader) Read() (CollectionDocumentCreat
 		return CollectionDocumentCreateResponse{}, err
 	}
 
+	// The old document is returned only if the document has been overwritten,
+	// e.g. it is not returned for the newly inserted documents.
+	var err error
+	if meta.Old, err = unmarshalReturnedDocument(c.array.ElementDecoder(), c.response.Old, meta.Old); err != nil {
+		return CollectionDocumentCreateResponse{}, err
+	}
+	if meta.New, err = unmarshalReturnedDocument(c.array.ElementDecoder(), c.response.New, meta.New); err != nil {
+		return CollectionDocumentCreateResponse{}, err
+	}
+
 	if meta.Error != nil && *meta.Error {
-		return meta, meta.AsArangoError()
+		return meta, withWriteConcernError(c.collection, meta.AsArangoError())
 	}
 
 	return meta, nil
 }
+
+// unmarshalReturnedDocument unmarshals the returned document into the given object with the given decoder.
+// It returns nil when the document has not been returned.
+func unmarshalReturnedDocument(decoder connection.Decoder, data json.RawMessage, obj interface{}) (interface{}, error) {
+	if obj == nil || len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	into := newUnmarshalInto(obj)
+	into.BindDecoder(decoder)
+	if err := into.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
@@ -50,6 +50,14 @@ type CollectionDocumentDelete interface {
 	// If no document exists with a given key, a NotFoundError is returned at its errors index.
 	// 'documents' must be a slice of structs with a `_key` field or a slice of keys.
 	DeleteDocumentsWithOptions(ctx context.Context, documents interface{}, opts *CollectionDocumentDeleteOptions) (CollectionDocumentDeleteResponseReader, error)
+
+	// RemoveByFilter removes all documents matching the given AQL filter expression and returns
+	// the number of removed documents.
+	// It runs `FOR d IN <collection> FILTER <filterExpr> REMOVE d IN <collection>`, so the expression
+	// must refer to the document as `d`, e.g. `d.age < @age`.
+	// Values must be passed via bindVars, never concatenated into filterExpr.
+	// The bind parameter `@@collection` is reserved for the collection name.
+	RemoveByFilter(ctx context.Context, filterExpr string, bindVars map[string]interface{}) (int64, error)
 }
 
 type CollectionDocumentDeleteResponse struct {
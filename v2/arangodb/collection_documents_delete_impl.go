@@ -22,8 +22,10 @@ package arangodb
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -56,7 +58,7 @@ func (c collectionDocumentDelete) DeleteDocumentWithOptions(ctx context.Context,
 		meta.Old = opts.OldObject
 	}
 
-	resp, err := connection.CallDelete(ctx, c.collection.connection(), url, &meta, c.collection.withModifiers(opts.modifyRequest)...)
+	resp, err := connection.CallDelete(ctx, c.collection.connection(), url, &meta, c.collection.withModifiers(opts.modifyRequest, withTransactionLimits(ctx))...)
 	if err != nil {
 		return CollectionDocumentDeleteResponse{}, err
 	}
@@ -65,7 +67,7 @@ func (c collectionDocumentDelete) DeleteDocumentWithOptions(ctx context.Context,
 	case http.StatusOK, http.StatusAccepted:
 		return meta, nil
 	default:
-		return CollectionDocumentDeleteResponse{}, meta.AsArangoErrorWithCode(code)
+		return CollectionDocumentDeleteResponse{}, withWriteConcernError(c.collection.name, meta.AsArangoErrorWithCode(code))
 	}
 }
 
@@ -85,7 +87,7 @@ func (c collectionDocumentDelete) DeleteDocumentsWithOptions(ctx context.Context
 		return nil, err
 	}
 
-	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, connection.WithBody(documents)) {
+	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, withTransactionLimits(ctx), connection.WithBody(documents)) {
 		if err = modifier(req); err != nil {
 			return nil, err
 		}
@@ -97,11 +99,40 @@ func (c collectionDocumentDelete) DeleteDocumentsWithOptions(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	return newCollectionDocumentDeleteResponseReader(&arr, opts), nil
+	return newCollectionDocumentDeleteResponseReader(&arr, opts, c.collection.name), nil
 }
 
-func newCollectionDocumentDeleteResponseReader(array *connection.Array, options *CollectionDocumentDeleteOptions) *collectionDocumentDeleteResponseReader {
-	c := &collectionDocumentDeleteResponseReader{array: array, options: options}
+// removeByFilterCollectionBindVar is the bind parameter reserved by RemoveByFilter for the collection name.
+const removeByFilterCollectionBindVar = "@collection"
+
+func (c collectionDocumentDelete) RemoveByFilter(ctx context.Context, filterExpr string, bindVars map[string]interface{}) (int64, error) {
+	if strings.TrimSpace(filterExpr) == "" {
+		return 0, errors.WithStack(shared.InvalidArgumentError{Message: "filter expression must be set"})
+	}
+	if _, ok := bindVars[removeByFilterCollectionBindVar]; ok {
+		return 0, errors.WithStack(shared.InvalidArgumentError{
+			Message: fmt.Sprintf("bind parameter '@%s' is reserved", removeByFilterCollectionBindVar),
+		})
+	}
+
+	vars := make(map[string]interface{}, len(bindVars)+1)
+	for k, v := range bindVars {
+		vars[k] = v
+	}
+	vars[removeByFilterCollectionBindVar] = c.collection.name
+
+	query := "FOR d IN @@collection FILTER " + filterExpr + " REMOVE d IN @@collection"
+	cursor, err := c.collection.db.Query(ctx, query, &QueryOptions{BindVars: vars})
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer cursor.Close()
+
+	return int64(cursor.Statistics().WritesExecutedInt), nil
+}
+
+func newCollectionDocumentDeleteResponseReader(array *connection.Array, options *CollectionDocumentDeleteOptions, col string) *collectionDocumentDeleteResponseReader {
+	c := &collectionDocumentDeleteResponseReader{array: array, options: options, collection: col}
 
 	return c
 }
@@ -109,8 +140,9 @@ func newCollectionDocumentDeleteResponseReader(array *connection.Array, options
 var _ CollectionDocumentDeleteResponseReader = &collectionDocumentDeleteResponseReader{}
 
 type collectionDocumentDeleteResponseReader struct {
-	array   *connection.Array
-	options *CollectionDocumentDeleteOptions
+	array      *connection.Array
+	options    *CollectionDocumentDeleteOptions
+	collection string
 }
 
 func (c *collectionDocumentDeleteResponseReader) Read(i interface{}) (CollectionDocumentDeleteResponse, error) {
@@ -132,7 +164,7 @@ func (c *collectionDocumentDeleteResponseReader) Read(i interface{}) (Collection
 	}
 
 	if meta.Error != nil && *meta.Error {
-		return meta, meta.AsArangoError()
+		return meta, withWriteConcernError(c.collection, meta.AsArangoError())
 	}
 
 	return meta, nil
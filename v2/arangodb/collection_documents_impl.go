@@ -23,6 +23,7 @@ package arangodb
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
 	"github.com/arangodb/go-driver/v2/connection"
@@ -36,6 +37,9 @@ func newCollectionDocuments(collection *collection) *collectionDocuments {
 	d.collectionDocumentRead = newCollectionDocumentRead(d.collection)
 	d.collectionDocumentCreate = newCollectionDocumentCreate(d.collection)
 	d.collectionDocumentDelete = newCollectionDocumentDelete(d.collection)
+	d.collectionDocumentUpsert = newCollectionDocumentUpsert(d.collection)
+	d.collectionDocumentAll = newCollectionDocumentAll(d.collection)
+	d.collectionDocumentImport = newCollectionDocumentImport(d.collection)
 
 	return d
 }
@@ -52,26 +56,96 @@ type collectionDocuments struct {
 	*collectionDocumentRead
 	*collectionDocumentCreate
 	*collectionDocumentDelete
+	*collectionDocumentUpsert
+	*collectionDocumentAll
+	*collectionDocumentImport
 }
 
 func (c collectionDocuments) DocumentExists(ctx context.Context, key string) (bool, error) {
+	exists, _, err := c.DocumentExistsWithRevision(ctx, key)
+	return exists, err
+}
+
+func (c collectionDocuments) DocumentExistsWithRevision(ctx context.Context, key string) (bool, string, error) {
 	url := c.collection.url("document", key)
 
 	resp, err := connection.CallHead(ctx, c.collection.connection(), url, nil, c.collection.withModifiers()...)
 
 	if err != nil {
 		if shared.IsNotFound(err) {
-			return false, nil
+			return false, "", nil
 		}
-		return false, err
+		return false, "", err
 	}
 
 	switch code := resp.Code(); code {
 	case http.StatusOK:
-		return true, nil
+		return true, strings.Trim(resp.Header("ETag"), "\""), nil
 	case http.StatusNotFound:
-		return false, nil
+		return false, "", nil
 	default:
-		return false, shared.NewResponseStruct().AsArangoErrorWithCode(code)
+		return false, "", shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
+
+// existingKeysBatchSize is the maximum number of keys checked with one query by ExistingKeys.
+const existingKeysBatchSize = 1000
+
+func (c collectionDocuments) ExistingKeys(ctx context.Context, keys []string) ([]string, error) {
+	// The same key in different batches must not be returned twice.
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			unique = append(unique, key)
+		}
+	}
+	keys = unique
+
+	existing := make([]string, 0, len(keys))
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > existingKeysBatchSize {
+			batch = batch[:existingKeysBatchSize]
+		}
+		keys = keys[len(batch):]
+
+		found, err := c.existingKeys(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, found...)
 	}
+
+	return existing, nil
+}
+
+// existingKeys returns the keys from the given batch which documents exist in the collection.
+func (c collectionDocuments) existingKeys(ctx context.Context, keys []string) ([]string, error) {
+	query := "FOR d IN @@collection FILTER d._key IN @keys RETURN d._key"
+	opts := &QueryOptions{
+		BatchSize: len(keys),
+		BindVars: map[string]interface{}{
+			"@collection": c.collection.name,
+			"keys":        keys,
+		},
+	}
+
+	cursor, err := c.collection.db.Query(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var found []string
+	for cursor.HasMore() {
+		var key string
+		if _, err := cursor.ReadDocument(ctx, &key); err != nil {
+			return nil, err
+		}
+		found = append(found, key)
+	}
+
+	return found, nil
 }
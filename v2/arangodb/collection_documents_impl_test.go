@@ -0,0 +1,222 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_collectionDocuments_WriteOptions(t *testing.T) {
+	recorder := &requestRecorder{}
+	col := newTestCollection(t, recorder.ServeHTTP)
+
+	doc := map[string]string{"_key": "k"}
+	var oldDoc, newDoc map[string]string
+
+	testCases := map[string]struct {
+		code     int
+		body     string
+		run      func(ctx context.Context) error
+		expected map[string]string
+	}{
+		"create": {
+			code: http.StatusCreated,
+			body: `{"_key":"k"}`,
+			run: func(ctx context.Context) error {
+				_, err := col.CreateDocumentWithOptions(ctx, doc, &CollectionDocumentCreateOptions{
+					WithWaitForSync: utils.NewType(true),
+					Silent:          utils.NewType(false),
+					NewObject:       &newDoc,
+					OldObject:       &oldDoc,
+					KeepNull:        utils.NewType(false),
+					MergeObjects:    utils.NewType(false),
+					IgnoreRevs:      utils.NewType(false),
+				})
+				return err
+			},
+			expected: map[string]string{
+				QueryWaitForSync: "true", QuerySilent: "false", QueryReturnNew: "true", QueryReturnOld: "true",
+				QueryKeepNull: "false", QueryMergeObjects: "false", QueryIgnoreRevs: "false",
+			},
+		},
+		"create many": {
+			code: http.StatusCreated,
+			body: `[]`,
+			run: func(ctx context.Context) error {
+				_, err := col.CreateDocumentsWithOptions(ctx, []map[string]string{doc}, &CollectionDocumentCreateOptions{
+					WithWaitForSync: utils.NewType(true),
+					Silent:          utils.NewType(true),
+					NewObject:       &newDoc,
+					OldObject:       &oldDoc,
+					KeepNull:        utils.NewType(true),
+					MergeObjects:    utils.NewType(true),
+					IgnoreRevs:      utils.NewType(true),
+				})
+				return err
+			},
+			expected: map[string]string{
+				QueryWaitForSync: "true", QuerySilent: "true", QueryReturnNew: "true", QueryReturnOld: "true",
+				QueryKeepNull: "true", QueryMergeObjects: "true", QueryIgnoreRevs: "true",
+			},
+		},
+		"update": {
+			code: http.StatusCreated,
+			body: `{"_key":"k"}`,
+			run: func(ctx context.Context) error {
+				_, err := col.UpdateDocumentWithOptions(ctx, "k", doc, &CollectionDocumentUpdateOptions{
+					WithWaitForSync: utils.NewType(false),
+					Silent:          utils.NewType(false),
+					NewObject:       &newDoc,
+					OldObject:       &oldDoc,
+					KeepNull:        utils.NewType(false),
+					MergeObjects:    utils.NewType(true),
+					IgnoreRevs:      utils.NewType(false),
+				})
+				return err
+			},
+			expected: map[string]string{
+				QueryWaitForSync: "false", QuerySilent: "false", QueryReturnNew: "true", QueryReturnOld: "true",
+				QueryKeepNull: "false", QueryMergeObjects: "true", QueryIgnoreRevs: "false",
+			},
+		},
+		"update many": {
+			code: http.StatusCreated,
+			body: `[]`,
+			run: func(ctx context.Context) error {
+				_, err := col.UpdateDocumentsWithOptions(ctx, []map[string]string{doc}, &CollectionDocumentUpdateOptions{
+					WithWaitForSync: utils.NewType(true),
+					KeepNull:        utils.NewType(true),
+					MergeObjects:    utils.NewType(false),
+					IgnoreRevs:      utils.NewType(true),
+				})
+				return err
+			},
+			expected: map[string]string{
+				QueryWaitForSync: "true", QueryKeepNull: "true", QueryMergeObjects: "false", QueryIgnoreRevs: "true",
+			},
+		},
+		"replace": {
+			code: http.StatusCreated,
+			body: `{"_key":"k"}`,
+			run: func(ctx context.Context) error {
+				_, err := col.ReplaceDocumentWithOptions(ctx, "k", doc, &CollectionDocumentReplaceOptions{
+					WithWaitForSync: utils.NewType(true),
+					Silent:          utils.NewType(false),
+					NewObject:       &newDoc,
+					OldObject:       &oldDoc,
+					IgnoreRevs:      utils.NewType(false),
+				})
+				return err
+			},
+			expected: map[string]string{
+				QueryWaitForSync: "true", QuerySilent: "false", QueryReturnNew: "true", QueryReturnOld: "true",
+				QueryIgnoreRevs: "false",
+			},
+		},
+		"delete": {
+			code: http.StatusOK,
+			body: `{"_key":"k"}`,
+			run: func(ctx context.Context) error {
+				_, err := col.DeleteDocumentWithOptions(ctx, "k", &CollectionDocumentDeleteOptions{
+					WithWaitForSync: utils.NewType(true),
+					Silent:          utils.NewType(false),
+					OldObject:       &oldDoc,
+					IgnoreRevs:      utils.NewType(false),
+				})
+				return err
+			},
+			expected: map[string]string{
+				QueryWaitForSync: "true", QuerySilent: "false", QueryReturnOld: "true", QueryIgnoreRevs: "false",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			recorder.code, recorder.body = testCase.code, testCase.body
+			recorder.queries = nil
+
+			require.NoError(t, testCase.run(context.Background()))
+			require.Len(t, recorder.queries, 1)
+			for key, value := range testCase.expected {
+				require.Equal(t, value, recorder.queries[0].Get(key), "query parameter %s", key)
+			}
+		})
+	}
+
+	t.Run("no options", func(t *testing.T) {
+		recorder.code, recorder.body = http.StatusCreated, `{"_key":"k"}`
+		recorder.queries = nil
+
+		_, err := col.UpdateDocumentWithOptions(context.Background(), "k", doc, nil)
+		require.NoError(t, err)
+		require.Len(t, recorder.queries, 1)
+		require.Empty(t, recorder.queries[0])
+	})
+}
+
+func Test_collectionDocuments_WriteConcernNotMet(t *testing.T) {
+	recorder := &requestRecorder{}
+	col := newTestCollection(t, recorder.ServeHTTP)
+
+	const writeConcernError = `{"error":true,"errorNum":1429,"errorMessage":"not enough replicas for write"}`
+
+	t.Run("single document", func(t *testing.T) {
+		recorder.code, recorder.body = http.StatusServiceUnavailable, writeConcernError
+
+		_, err := col.CreateDocument(context.Background(), map[string]string{"_key": "k"})
+		require.True(t, IsWriteConcernNotMet(err))
+
+		var wcErr WriteConcernNotMetError
+		require.ErrorAs(t, err, &wcErr)
+		require.Equal(t, "col", wcErr.Collection)
+		require.Contains(t, err.Error(), "'col'")
+	})
+
+	t.Run("many documents", func(t *testing.T) {
+		recorder.code, recorder.body = http.StatusAccepted, `[`+writeConcernError+`]`
+
+		reader, err := col.UpdateDocuments(context.Background(), []map[string]string{{"_key": "k"}})
+		require.NoError(t, err)
+
+		_, err = reader.Read()
+		require.True(t, IsWriteConcernNotMet(err))
+
+		var wcErr WriteConcernNotMetError
+		require.ErrorAs(t, err, &wcErr)
+		require.Equal(t, "col", wcErr.Collection)
+	})
+
+	t.Run("other errors are not wrapped", func(t *testing.T) {
+		recorder.code, recorder.body = http.StatusNotFound, `{"error":true,"errorNum":1202,"errorMessage":"document not found"}`
+
+		_, err := col.DeleteDocument(context.Background(), "k")
+		require.False(t, IsWriteConcernNotMet(err))
+		require.False(t, errors.As(err, &WriteConcernNotMetError{}))
+	})
+}
@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// CollectionDocumentImport imports documents into a collection in bulk.
+type CollectionDocumentImport interface {
+	// ImportFromReader imports the documents read from the given reader.
+	// The input must contain one JSON document per line (newline-delimited JSON).
+	// The input is streamed to the server as it is, so it is never buffered in the memory of the client.
+	// Lines which can not be parsed are counted as errors, unless ImportDocumentOptions.Complete is set,
+	// in which case the whole import fails.
+	// When the request is sent again, e.g. by the retry wrappers of the connection, a *bytes.Buffer or io.Seeker reader
+	// (e.g. *os.File) is rewound to the position at which the import has started.
+	// Other readers can be consumed only once, so the request with them is not sent again.
+	ImportFromReader(ctx context.Context, r io.Reader, opts *ImportDocumentOptions) (ImportDocumentStatistics, error)
+}
+
+// ImportDocumentOptions holds optional options that control the import document process.
+type ImportDocumentOptions struct {
+	// FromPrefix is an optional prefix for the values in _from attributes. If specified, the value is automatically
+	// prepended to each _from input value. This allows specifying just the keys for _from.
+	FromPrefix string
+
+	// ToPrefix is an optional prefix for the values in _to attributes. If specified, the value is automatically
+	// prepended to each _to input value. This allows specifying just the keys for _to.
+	ToPrefix string
+
+	// Overwrite is a flag that if set, then all data in the collection will be removed prior to the import.
+	// Note that any existing index definitions will be preserved.
+	Overwrite bool
+
+	// OnDuplicate controls what action is carried out in case of a unique key constraint violation.
+	OnDuplicate ImportOnDuplicate
+
+	// Complete is a flag that if set, will make the whole import fail if any error occurs.
+	// Otherwise, the import will continue even if some documents cannot be imported.
+	Complete bool
+
+	// Details is a flag that if set, the statistics will contain the messages of the errors
+	// with the details about the documents which could not be imported.
+	Details bool
+
+	// WaitForSync when set to true waits until the documents have been synced to disk.
+	WaitForSync bool
+}
+
+func (o *ImportDocumentOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.FromPrefix != "" {
+		r.AddQuery("fromPrefix", o.FromPrefix)
+	}
+	if o.ToPrefix != "" {
+		r.AddQuery("toPrefix", o.ToPrefix)
+	}
+	if o.Overwrite {
+		r.AddQuery("overwrite", "true")
+	}
+	if o.OnDuplicate != "" {
+		r.AddQuery("onDuplicate", string(o.OnDuplicate))
+	}
+	if o.Complete {
+		r.AddQuery("complete", "true")
+	}
+	if o.Details {
+		r.AddQuery("details", "true")
+	}
+	if o.WaitForSync {
+		r.AddQuery(QueryWaitForSync, "true")
+	}
+
+	return nil
+}
+
+// ImportOnDuplicate is a type to control what action is carried out in case of a unique key constraint violation.
+type ImportOnDuplicate string
+
+const (
+	// ImportOnDuplicateError will not import the current document because of the unique key constraint violation.
+	// This is the default setting.
+	ImportOnDuplicateError ImportOnDuplicate = "error"
+	// ImportOnDuplicateUpdate will update an existing document in the database with the data specified in the request.
+	// Attributes of the existing document that are not present in the request will be preserved.
+	ImportOnDuplicateUpdate ImportOnDuplicate = "update"
+	// ImportOnDuplicateReplace will replace an existing document in the database with the data specified in the request.
+	ImportOnDuplicateReplace ImportOnDuplicate = "replace"
+	// ImportOnDuplicateIgnore will not update an existing document and simply ignore the error caused by a unique key constraint violation.
+	ImportOnDuplicateIgnore ImportOnDuplicate = "ignore"
+)
+
+// ImportDocumentStatistics holds statistics of an import action.
+type ImportDocumentStatistics struct {
+	// Created holds the number of documents imported.
+	Created int64 `json:"created,omitempty"`
+	// Errors holds the number of documents that were not imported due to an error, including the malformed lines.
+	Errors int64 `json:"errors,omitempty"`
+	// Empty holds the number of empty lines found in the input.
+	Empty int64 `json:"empty,omitempty"`
+	// Updated holds the number of updated/replaced documents (in case OnDuplicate was set to either update or replace).
+	Updated int64 `json:"updated,omitempty"`
+	// Ignored holds the number of failed but ignored insert operations (in case OnDuplicate was set to ignore).
+	Ignored int64 `json:"ignored,omitempty"`
+	// Details contains the messages about the documents which could not be imported.
+	// It is set only when ImportDocumentOptions.Details is set.
+	Details []string `json:"details,omitempty"`
+}
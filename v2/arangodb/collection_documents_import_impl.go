@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newCollectionDocumentImport(collection *collection) *collectionDocumentImport {
+	return &collectionDocumentImport{
+		collection: collection,
+	}
+}
+
+var _ CollectionDocumentImport = &collectionDocumentImport{}
+
+type collectionDocumentImport struct {
+	collection *collection
+}
+
+func (c collectionDocumentImport) ImportFromReader(ctx context.Context, r io.Reader, opts *ImportDocumentOptions) (ImportDocumentStatistics, error) {
+	if r == nil {
+		return ImportDocumentStatistics{}, shared.InvalidArgumentError{Message: "reader must not be nil"}
+	}
+
+	url := c.collection.db.url("_api", "import")
+
+	var response struct {
+		shared.ResponseStruct    `json:",inline"`
+		ImportDocumentStatistics `json:",inline"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.collection.connection(), url, &response, r,
+		c.collection.withModifiers(withJSONLinesContent, connection.WithQuery("collection", c.collection.name),
+			connection.WithQuery("type", "documents"), opts.modifyRequest, withTransactionLimits(ctx))...)
+	if err != nil {
+		return ImportDocumentStatistics{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		return response.ImportDocumentStatistics, nil
+	default:
+		return ImportDocumentStatistics{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// withJSONLinesContent sets the content type of the request body to JSON,
+// because the newline-delimited documents are sent as they are, also by the VelocyPack connections.
+func withJSONLinesContent(r connection.Request) error {
+	r.AddHeader(connection.ContentType, connection.ApplicationJSON)
+	return nil
+}
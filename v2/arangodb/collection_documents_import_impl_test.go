@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func Test_collectionDocumentImport_ImportFromReader(t *testing.T) {
+	var body string
+	var query url.Values
+	var contentType string
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body, query, contentType = string(data), r.URL.Query(), r.Header.Get("Content-Type")
+
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"error":false,"created":2,"errors":1,"empty":1,"details":["at position 2: invalid JSON"]}`))
+	})
+
+	input := "{\"_key\":\"a\"}\n\n{invalid\n{\"_key\":\"b\"}\n"
+	stats, err := col.ImportFromReader(context.Background(), strings.NewReader(input), &ImportDocumentOptions{
+		OnDuplicate: ImportOnDuplicateIgnore,
+		Details:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ImportDocumentStatistics{
+		Created: 2,
+		Errors:  1,
+		Empty:   1,
+		Details: []string{"at position 2: invalid JSON"},
+	}, stats)
+
+	require.Equal(t, input, body)
+	require.Equal(t, connection.ApplicationJSON, contentType)
+	require.Equal(t, "col", query.Get("collection"))
+	require.Equal(t, "documents", query.Get("type"))
+	require.Equal(t, "ignore", query.Get("onDuplicate"))
+	require.Equal(t, "true", query.Get("details"))
+	require.False(t, query.Has("complete"))
+
+	_, err = col.ImportFromReader(context.Background(), nil, nil)
+	require.Error(t, err)
+}
+
+func Test_collectionDocumentImport_ImportFromReader_Retry(t *testing.T) {
+	var bodies []string
+	conn := newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(data))
+
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":true,"code":503,"errorNum":503,"errorMessage":"service unavailable"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"error":false,"created":2}`))
+	})
+	col := getTestCollection(t, newDatabase(newClient(connection.RetryOn503(conn, 3)), "db"))
+
+	input := "{\"_key\":\"a\"}\n{\"_key\":\"b\"}\n"
+
+	t.Run("rewindable reader is sent again", func(t *testing.T) {
+		bodies = nil
+
+		stats, err := col.ImportFromReader(context.Background(), strings.NewReader(input), nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), stats.Created)
+		require.Equal(t, []string{input, input}, bodies)
+	})
+
+	t.Run("other reader is not sent again", func(t *testing.T) {
+		bodies = nil
+
+		_, err := col.ImportFromReader(context.Background(), io.MultiReader(strings.NewReader(input)), nil)
+		require.Error(t, err)
+		require.Equal(t, []string{input}, bodies)
+	})
+}
@@ -37,6 +37,7 @@ type CollectionDocumentRead interface {
 
 	// ReadDocumentWithOptions reads a single document with given key from the collection.
 	// The document data is stored into result, the document metadata is returned.
+	// The revision in the metadata is populated also when result does not contain the `_rev` field.
 	// If no document exists with given key, a NotFoundError is returned.
 	ReadDocumentWithOptions(ctx context.Context, key string, result interface{}, opts *CollectionDocumentReadOptions) (DocumentMeta, error)
 
@@ -86,6 +87,7 @@ type CollectionDocumentReadOptions struct {
 	// This option is ignored if this operation is part of a DatabaseTransaction (TransactionID option).
 	// The header set when creating the transaction decides about dirty reads for the entire transaction,
 	// not the individual read operations.
+	// The returned DocumentMeta.WasDirtyRead reports whether the read was potentially a dirty read.
 	AllowDirtyReads *bool
 
 	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
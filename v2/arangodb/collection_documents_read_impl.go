@@ -24,6 +24,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
 	"github.com/arangodb/go-driver/v2/connection"
@@ -49,7 +50,7 @@ func (c collectionDocumentRead) ReadDocumentsWithOptions(ctx context.Context, do
 		return nil, err
 	}
 
-	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, connection.WithBody(documents),
+	for _, modifier := range c.collection.withModifiers(withDirtyRead(ctx), opts.modifyRequest, connection.WithBody(documents),
 		connection.WithFragment("get"), connection.WithQuery("onlyget", "true")) {
 		if err = modifier(req); err != nil {
 			return nil, err
@@ -57,10 +58,15 @@ func (c collectionDocumentRead) ReadDocumentsWithOptions(ctx context.Context, do
 	}
 
 	var arr connection.Array
-	if _, err := c.collection.connection().Do(ctx, req, &arr, http.StatusOK); err != nil {
+	resp, err := c.collection.connection().Do(ctx, req, &arr, http.StatusOK)
+	if err != nil {
 		return nil, err
 	}
-	return newCollectionDocumentReadResponseReader(&arr, opts), nil
+	readDirtyReadResponse(ctx, resp)
+
+	reader := newCollectionDocumentReadResponseReader(&arr, opts)
+	reader.wasDirtyRead = isDirtyReadResponse(resp)
+	return reader, nil
 }
 
 func (c collectionDocumentRead) ReadDocuments(ctx context.Context, keys []string) (CollectionDocumentReadResponseReader, error) {
@@ -81,13 +87,19 @@ func (c collectionDocumentRead) ReadDocumentWithOptions(ctx context.Context, key
 
 	data := newUnmarshalInto(result)
 
-	resp, err := connection.CallGet(ctx, c.collection.connection(), url, newMultiUnmarshaller(&response, data), c.collection.withModifiers(opts.modifyRequest)...)
+	resp, err := connection.CallGet(ctx, c.collection.connection(), url, newMultiUnmarshaller(&response, data), c.collection.withModifiers(withDirtyRead(ctx), opts.modifyRequest)...)
 	if err != nil {
 		return DocumentMeta{}, err
 	}
 
 	switch code := resp.Code(); code {
 	case http.StatusOK:
+		readDirtyReadResponse(ctx, resp)
+		response.WasDirtyRead = isDirtyReadResponse(resp)
+		if response.Rev == "" {
+			// The revision is always available in the ETag header.
+			response.Rev = strings.Trim(resp.Header("ETag"), "\"")
+		}
 		return response.DocumentMeta, nil
 	default:
 		return DocumentMeta{}, response.AsArangoErrorWithCode(code)
@@ -105,6 +117,9 @@ var _ CollectionDocumentReadResponseReader = &collectionDocumentReadResponseRead
 type collectionDocumentReadResponseReader struct {
 	array   *connection.Array
 	options *CollectionDocumentReadOptions
+
+	// wasDirtyRead is true when the documents have been read from a shard replica which may not be in sync.
+	wasDirtyRead bool
 }
 
 func (c *collectionDocumentReadResponseReader) Read(i interface{}) (CollectionDocumentReadResponse, error) {
@@ -125,5 +140,7 @@ func (c *collectionDocumentReadResponseReader) Read(i interface{}) (CollectionDo
 		return meta, meta.AsArangoError()
 	}
 
+	meta.WasDirtyRead = c.wasDirtyRead
+
 	return meta, nil
 }
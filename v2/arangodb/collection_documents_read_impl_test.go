@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_collectionDocumentRead_RevisionFromETag(t *testing.T) {
+	body := `{"_key":"john","_id":"col/john","_rev":"_rev-body","name":"John"}`
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_db/db/_api/document/col/john", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"_rev-etag"`)
+		w.Write([]byte(body))
+	})
+
+	var doc struct {
+		Name string `json:"name"`
+	}
+
+	t.Run("revision from the body", func(t *testing.T) {
+		meta, err := col.ReadDocumentWithOptions(context.Background(), "john", &doc, nil)
+		require.NoError(t, err)
+		require.Equal(t, "_rev-body", meta.Rev)
+		require.Equal(t, "John", doc.Name)
+	})
+
+	t.Run("revision from the ETag", func(t *testing.T) {
+		body = `{"_key":"john","_id":"col/john","name":"John"}`
+
+		meta, err := col.ReadDocumentWithOptions(context.Background(), "john", &doc, nil)
+		require.NoError(t, err)
+		require.Equal(t, "_rev-etag", meta.Rev)
+		require.Equal(t, "john", meta.Key)
+	})
+}
+
+func Test_collectionDocumentRead_WasDirtyRead(t *testing.T) {
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get(HeaderDirtyReads) == "true" {
+			w.Header().Set(HeaderPotentialDirtyRead, "true")
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"_key":"john","_id":"col/john","_rev":"_r"}`))
+		default:
+			w.Write([]byte(`[{"_key":"john","_id":"col/john","_rev":"_r"}]`))
+		}
+	})
+
+	for _, allowDirtyReads := range []bool{true, false} {
+		opts := &CollectionDocumentReadOptions{AllowDirtyReads: &allowDirtyReads}
+
+		t.Run(fmt.Sprintf("single read with AllowDirtyReads=%t", allowDirtyReads), func(t *testing.T) {
+			meta, err := col.ReadDocumentWithOptions(context.Background(), "john", nil, opts)
+			require.NoError(t, err)
+			require.Equal(t, allowDirtyReads, meta.WasDirtyRead)
+		})
+
+		t.Run(fmt.Sprintf("bulk read with AllowDirtyReads=%t", allowDirtyReads), func(t *testing.T) {
+			reader, err := col.ReadDocumentsWithOptions(context.Background(), []string{"john"}, opts)
+			require.NoError(t, err)
+
+			meta, err := reader.Read(nil)
+			require.NoError(t, err)
+			require.Equal(t, "john", meta.Key)
+			require.Equal(t, allowDirtyReads, meta.WasDirtyRead)
+		})
+	}
+}
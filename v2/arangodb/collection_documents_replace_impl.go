@@ -71,7 +71,7 @@ func (c collectionDocumentReplace) ReplaceDocumentWithOptions(ctx context.Contex
 		New: newUnmarshalInto(meta.New),
 	}
 
-	resp, err := connection.CallPut(ctx, c.collection.connection(), url, &response, document, c.collection.withModifiers(options.modifyRequest)...)
+	resp, err := connection.CallPut(ctx, c.collection.connection(), url, &response, document, c.collection.withModifiers(options.modifyRequest, withTransactionLimits(ctx))...)
 	if err != nil {
 		return CollectionDocumentReplaceResponse{}, err
 	}
@@ -82,7 +82,7 @@ func (c collectionDocumentReplace) ReplaceDocumentWithOptions(ctx context.Contex
 	case http.StatusAccepted:
 		return meta, nil
 	default:
-		return CollectionDocumentReplaceResponse{}, response.AsArangoErrorWithCode(code)
+		return CollectionDocumentReplaceResponse{}, withWriteConcernError(c.collection.name, response.AsArangoErrorWithCode(code))
 	}
 }
 
@@ -102,7 +102,7 @@ func (c collectionDocumentReplace) ReplaceDocumentsWithOptions(ctx context.Conte
 		return nil, err
 	}
 
-	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, connection.WithBody(documents), connection.WithFragment("multiple")) {
+	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, withTransactionLimits(ctx), connection.WithBody(documents), connection.WithFragment("multiple")) {
 		if err = modifier(req); err != nil {
 			return nil, err
 		}
@@ -119,14 +119,14 @@ func (c collectionDocumentReplace) ReplaceDocumentsWithOptions(ctx context.Conte
 	case http.StatusCreated:
 		fallthrough
 	case http.StatusAccepted:
-		return newCollectionDocumentReplaceResponseReader(&arr, opts), nil
+		return newCollectionDocumentReplaceResponseReader(&arr, opts, c.collection.name), nil
 	default:
 		return nil, shared.NewResponseStruct().AsArangoErrorWithCode(code)
 	}
 }
 
-func newCollectionDocumentReplaceResponseReader(array *connection.Array, options *CollectionDocumentReplaceOptions) *collectionDocumentReplaceResponseReader {
-	c := &collectionDocumentReplaceResponseReader{array: array, options: options}
+func newCollectionDocumentReplaceResponseReader(array *connection.Array, options *CollectionDocumentReplaceOptions, col string) *collectionDocumentReplaceResponseReader {
+	c := &collectionDocumentReplaceResponseReader{array: array, options: options, collection: col}
 
 	if c.options != nil {
 		c.response.Old = newUnmarshalInto(c.options.OldObject)
@@ -139,9 +139,10 @@ func newCollectionDocumentReplaceResponseReader(array *connection.Array, options
 var _ CollectionDocumentReplaceResponseReader = &collectionDocumentReplaceResponseReader{}
 
 type collectionDocumentReplaceResponseReader struct {
-	array    *connection.Array
-	options  *CollectionDocumentReplaceOptions
-	response struct {
+	array      *connection.Array
+	options    *CollectionDocumentReplaceOptions
+	collection string
+	response   struct {
 		*DocumentMeta
 		*shared.ResponseStruct `json:",inline"`
 		Old                    *UnmarshalInto `json:"old,omitempty"`
@@ -172,7 +173,7 @@ func (c *collectionDocumentReplaceResponseReader) Read() (CollectionDocumentRepl
 	}
 
 	if meta.Error != nil && *meta.Error {
-		return meta, meta.AsArangoError()
+		return meta, withWriteConcernError(c.collection, meta.AsArangoError())
 	}
 
 	return meta, nil
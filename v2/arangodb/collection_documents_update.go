@@ -86,11 +86,14 @@ type CollectionDocumentUpdateOptions struct {
 	// You can use this option to save network traffic.
 	Silent *bool
 
-	// Additionally return the complete new document
+	// Additionally return the complete new document.
+	// It must be a pointer, e.g. to the user's struct, into which the new document is decoded.
+	// For multiple documents, it is overwritten by every Read of the response reader.
 	NewObject interface{}
 
-	// Additionally return the complete old document under the attribute.
-	// Only available if the overwrite option is used.
+	// Additionally return the complete old document.
+	// It must be a pointer, e.g. to the user's struct, into which the old document is decoded.
+	// For multiple documents, it is overwritten by every Read of the response reader.
 	OldObject interface{}
 
 	// RefillIndexCaches if set to true then refills the in-memory index caches.
@@ -71,7 +71,7 @@ func (c collectionDocumentUpdate) UpdateDocumentWithOptions(ctx context.Context,
 		New: newUnmarshalInto(meta.New),
 	}
 
-	resp, err := connection.CallPatch(ctx, c.collection.connection(), url, &response, document, c.collection.withModifiers(options.modifyRequest)...)
+	resp, err := connection.CallPatch(ctx, c.collection.connection(), url, &response, document, c.collection.withModifiers(options.modifyRequest, withTransactionLimits(ctx))...)
 	if err != nil {
 		return CollectionDocumentUpdateResponse{}, err
 	}
@@ -82,7 +82,7 @@ func (c collectionDocumentUpdate) UpdateDocumentWithOptions(ctx context.Context,
 	case http.StatusAccepted:
 		return meta, nil
 	default:
-		return CollectionDocumentUpdateResponse{}, response.AsArangoErrorWithCode(code)
+		return CollectionDocumentUpdateResponse{}, withWriteConcernError(c.collection.name, response.AsArangoErrorWithCode(code))
 	}
 }
 
@@ -102,7 +102,7 @@ func (c collectionDocumentUpdate) UpdateDocumentsWithOptions(ctx context.Context
 		return nil, err
 	}
 
-	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, connection.WithBody(documents), connection.WithFragment("multiple")) {
+	for _, modifier := range c.collection.withModifiers(opts.modifyRequest, withTransactionLimits(ctx), connection.WithBody(documents), connection.WithFragment("multiple")) {
 		if err = modifier(req); err != nil {
 			return nil, err
 		}
@@ -119,14 +119,14 @@ func (c collectionDocumentUpdate) UpdateDocumentsWithOptions(ctx context.Context
 	case http.StatusCreated:
 		fallthrough
 	case http.StatusAccepted:
-		return newCollectionDocumentUpdateResponseReader(&arr, opts), nil
+		return newCollectionDocumentUpdateResponseReader(&arr, opts, c.collection.name), nil
 	default:
 		return nil, shared.NewResponseStruct().AsArangoErrorWithCode(code)
 	}
 }
 
-func newCollectionDocumentUpdateResponseReader(array *connection.Array, options *CollectionDocumentUpdateOptions) *collectionDocumentUpdateResponseReader {
-	c := &collectionDocumentUpdateResponseReader{array: array, options: options}
+func newCollectionDocumentUpdateResponseReader(array *connection.Array, options *CollectionDocumentUpdateOptions, col string) *collectionDocumentUpdateResponseReader {
+	c := &collectionDocumentUpdateResponseReader{array: array, options: options, collection: col}
 
 	if c.options != nil {
 		c.response.Old = newUnmarshalInto(c.options.OldObject)
@@ -139,9 +139,10 @@ func newCollectionDocumentUpdateResponseReader(array *connection.Array, options
 var _ CollectionDocumentUpdateResponseReader = &collectionDocumentUpdateResponseReader{}
 
 type collectionDocumentUpdateResponseReader struct {
-	array    *connection.Array
-	options  *CollectionDocumentUpdateOptions
-	response struct {
+	array      *connection.Array
+	options    *CollectionDocumentUpdateOptions
+	collection string
+	response   struct {
 		*DocumentMeta
 		*shared.ResponseStruct `json:",inline"`
 		Old                    *UnmarshalInto `json:"old,omitempty"`
@@ -172,7 +173,7 @@ func (c *collectionDocumentUpdateResponseReader) Read() (CollectionDocumentUpdat
 	}
 
 	if meta.Error != nil && *meta.Error {
-		return meta, meta.AsArangoError()
+		return meta, withWriteConcernError(c.collection, meta.AsArangoError())
 	}
 
 	return meta, nil
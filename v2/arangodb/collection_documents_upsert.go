@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// CollectionDocumentUpsert inserts a document with a given key or updates it when it already exists.
+// It is a shortcut for the AQL `UPSERT { _key: ... } INSERT ... UPDATE ... IN ...` statement.
+// https://docs.arangodb.com/stable/aql/high-level-operations/upsert/
+type CollectionDocumentUpsert interface {
+	// Upsert inserts the insertDocument with a given key when no document with such key exists in the collection.
+	// Otherwise, the existing document is updated (or replaced, see CollectionDocumentUpsertOptions.Replace)
+	// with the updateDocument.
+	// The `_key` field of the inserted document is always set to the given key.
+	// Concurrent upserts with the same key are handled by the server, so the document is never inserted twice.
+	Upsert(ctx context.Context, key string, insertDocument, updateDocument interface{}, options *CollectionDocumentUpsertOptions) (CollectionDocumentUpsertResponse, error)
+}
+
+type CollectionDocumentUpsertResponse struct {
+	DocumentMeta
+
+	// Inserted is true when the document did not exist and the insertDocument has been inserted.
+	// It is false when the existing document has been updated or replaced.
+	Inserted bool
+
+	Old, New interface{}
+}
+
+type CollectionDocumentUpsertOptions struct {
+	// Replace when set to true, the existing document is replaced with the updateDocument instead of being updated.
+	Replace bool
+
+	// If the intention is to delete existing attributes with the update command, set it to false.
+	// This option controls the update behavior only.
+	KeepNull *bool
+
+	// Controls whether objects (not arrays) are merged if present in both, the existing and the update document.
+	// This option controls the update behavior only.
+	MergeObjects *bool
+
+	// Wait until document has been synced to disk.
+	WithWaitForSync *bool
+
+	// Exclusive when set to true, the collection is locked exclusively for the time of the operation.
+	// It serializes concurrent writes to the collection, so write-write conflicts are not possible.
+	Exclusive *bool
+
+	// Additionally return the complete new document
+	NewObject interface{}
+
+	// Additionally return the complete old document.
+	// It is left untouched when the document has been inserted.
+	OldObject interface{}
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
@@ -0,0 +1,159 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func newCollectionDocumentUpsert(collection *collection) *collectionDocumentUpsert {
+	return &collectionDocumentUpsert{
+		collection: collection,
+	}
+}
+
+var _ CollectionDocumentUpsert = &collectionDocumentUpsert{}
+
+type collectionDocumentUpsert struct {
+	collection *collection
+}
+
+func (c collectionDocumentUpsert) Upsert(ctx context.Context, key string, insertDocument, updateDocument interface{},
+	options *CollectionDocumentUpsertOptions) (CollectionDocumentUpsertResponse, error) {
+	if err := validateKey(key); err != nil {
+		return CollectionDocumentUpsertResponse{}, err
+	}
+
+	query, bindVars := options.query(c.collection.name, key, insertDocument, updateDocument)
+
+	queryOptions := &QueryOptions{
+		BindVars: bindVars,
+	}
+	if options != nil {
+		queryOptions.TransactionID = options.TransactionID
+	}
+	withQueryTransactionLimits(ctx, &queryOptions.Options)
+
+	resp, err := c.upsert(ctx, query, queryOptions, options)
+	if shared.IsArangoErrorWithErrorNum(err, shared.ErrArangoUniqueConstraintViolated) {
+		// The document has been inserted concurrently between the lookup and the insert,
+		// so the second attempt takes the update branch.
+		resp, err = c.upsert(ctx, query, queryOptions, options)
+	}
+
+	return resp, err
+}
+
+func (c collectionDocumentUpsert) upsert(ctx context.Context, query string, queryOptions *QueryOptions,
+	options *CollectionDocumentUpsertOptions) (CollectionDocumentUpsertResponse, error) {
+	cursor, err := c.collection.db.Query(ctx, query, queryOptions)
+	if err != nil {
+		return CollectionDocumentUpsertResponse{}, errors.WithStack(err)
+	}
+	defer cursor.Close()
+
+	var result CollectionDocumentUpsertResponse
+	if options != nil {
+		result.Old = options.OldObject
+		result.New = options.NewObject
+	}
+
+	response := struct {
+		Inserted bool           `json:"inserted"`
+		Old      *UnmarshalInto `json:"old,omitempty"`
+		New      *UnmarshalInto `json:"new,omitempty"`
+	}{
+		Old: newUnmarshalInto(result.Old),
+		New: newUnmarshalInto(result.New),
+	}
+
+	meta, err := cursor.ReadDocument(ctx, &response)
+	if err != nil {
+		return CollectionDocumentUpsertResponse{}, errors.WithStack(err)
+	}
+
+	result.DocumentMeta = meta
+	result.Inserted = response.Inserted
+
+	return result, nil
+}
+
+// query builds the UPSERT statement together with its bind parameters.
+func (o *CollectionDocumentUpsertOptions) query(colName, key string, insertDocument, updateDocument interface{}) (string, map[string]interface{}) {
+	bindVars := map[string]interface{}{
+		"@collection": colName,
+		"key":         key,
+		"insert":      insertDocument,
+		"update":      updateDocument,
+	}
+
+	operation := "UPDATE"
+	var opts []string
+	returnFields := []string{"_key: NEW._key", "_id: NEW._id", "_rev: NEW._rev", "inserted: OLD == null"}
+
+	if o != nil {
+		if o.Replace {
+			operation = "REPLACE"
+		}
+
+		if o.KeepNull != nil {
+			opts = append(opts, "keepNull: @keepNull")
+			bindVars["keepNull"] = *o.KeepNull
+		}
+
+		if o.MergeObjects != nil {
+			opts = append(opts, "mergeObjects: @mergeObjects")
+			bindVars["mergeObjects"] = *o.MergeObjects
+		}
+
+		if o.WithWaitForSync != nil {
+			opts = append(opts, "waitForSync: @waitForSync")
+			bindVars["waitForSync"] = *o.WithWaitForSync
+		}
+
+		if o.Exclusive != nil {
+			opts = append(opts, "exclusive: @exclusive")
+			bindVars["exclusive"] = *o.Exclusive
+		}
+
+		if o.OldObject != nil {
+			returnFields = append(returnFields, "old: OLD")
+		}
+
+		if o.NewObject != nil {
+			returnFields = append(returnFields, "new: NEW")
+		}
+	}
+
+	query := fmt.Sprintf("UPSERT { _key: @key } INSERT MERGE(@insert, { _key: @key }) %s @update IN @@collection", operation)
+	if len(opts) > 0 {
+		query += fmt.Sprintf(" OPTIONS { %s }", strings.Join(opts, ", "))
+	}
+	query += fmt.Sprintf(" RETURN { %s }", strings.Join(returnFields, ", "))
+
+	return query, bindVars
+}
@@ -0,0 +1,73 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// CollectionGeo runs geo queries on a collection with a geo index.
+// The returned documents contain an additional attribute with the distance in meters from the given point.
+type CollectionGeo interface {
+	// Near returns at most limit documents which are nearest to the given point, the nearest first.
+	// If the collection has no geo index, an InvalidArgumentError is returned.
+	Near(ctx context.Context, latitude, longitude float64, limit int, opts *GeoQueryOptions) (Cursor, error)
+
+	// Within returns the documents which are within the radius (in meters) around the given point, the nearest first.
+	// If the collection has no geo index, an InvalidArgumentError is returned.
+	Within(ctx context.Context, latitude, longitude, radius float64, opts *GeoQueryOptions) (Cursor, error)
+}
+
+// GeoQueryOptions contains options for the geo queries.
+type GeoQueryOptions struct {
+	// DistanceAttribute is the name of the attribute which holds the distance in the returned documents.
+	// Default: "distance".
+	DistanceAttribute string
+
+	// Index is the name or the ID of the geo index to use.
+	// Default: the first geo index of the collection.
+	Index string
+
+	// Limit is the maximum number of documents returned by Within. It is ignored by Near.
+	// Default: 0 (no limit).
+	Limit int
+}
+
+func (o *GeoQueryOptions) distanceAttribute() string {
+	if o == nil || o.DistanceAttribute == "" {
+		return "distance"
+	}
+	return o.DistanceAttribute
+}
+
+func (o *GeoQueryOptions) index() string {
+	if o == nil {
+		return ""
+	}
+	return o.Index
+}
+
+func (o *GeoQueryOptions) limit() int {
+	if o == nil {
+		return 0
+	}
+	return o.Limit
+}
@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+var _ CollectionGeo = &collection{}
+
+func (c collection) Near(ctx context.Context, latitude, longitude float64, limit int, opts *GeoQueryOptions) (Cursor, error) {
+	if limit <= 0 {
+		return nil, errors.WithStack(shared.InvalidArgumentError{Message: "limit must be greater than 0"})
+	}
+
+	return c.geoQuery(ctx, latitude, longitude, nil, limit, opts)
+}
+
+func (c collection) Within(ctx context.Context, latitude, longitude, radius float64, opts *GeoQueryOptions) (Cursor, error) {
+	if radius < 0 {
+		return nil, errors.WithStack(shared.InvalidArgumentError{Message: "radius can not be negative"})
+	}
+
+	return c.geoQuery(ctx, latitude, longitude, &radius, opts.limit(), opts)
+}
+
+// geoQuery runs a query which sorts the documents by the distance from the given point.
+// The documents farther than the radius are skipped if it is set.
+func (c collection) geoQuery(ctx context.Context, latitude, longitude float64, radius *float64, limit int,
+	opts *GeoQueryOptions) (Cursor, error) {
+	index, err := c.geoIndex(ctx, opts.index())
+	if err != nil {
+		return nil, err
+	}
+
+	bindVars := map[string]interface{}{
+		"@collection":       c.name,
+		"latitude":          latitude,
+		"longitude":         longitude,
+		"distanceAttribute": opts.distanceAttribute(),
+	}
+
+	var query strings.Builder
+	query.WriteString("FOR d IN @@collection LET distance = ")
+	query.WriteString(geoDistanceExpression(index))
+	if radius != nil {
+		query.WriteString(" FILTER distance <= @radius")
+		bindVars["radius"] = *radius
+	}
+	query.WriteString(" SORT distance ASC")
+	if limit > 0 {
+		query.WriteString(" LIMIT @limit")
+		bindVars["limit"] = limit
+	}
+	query.WriteString(" RETURN MERGE(d, { [@distanceAttribute]: distance })")
+
+	return c.db.Query(ctx, query.String(), &QueryOptions{BindVars: bindVars})
+}
+
+// geoIndex returns the geo index with the given name or ID, or the first geo index if the name is empty.
+func (c collection) geoIndex(ctx context.Context, name string) (IndexResponse, error) {
+	indexes, err := c.Indexes(ctx)
+	if err != nil {
+		return IndexResponse{}, err
+	}
+
+	for _, index := range indexes {
+		if index.Type != GeoIndexType || index.RegularIndex == nil || len(index.RegularIndex.Fields) == 0 {
+			continue
+		}
+		if name == "" || index.Name == name || index.ID == name || strings.HasSuffix(index.ID, "/"+name) {
+			return index, nil
+		}
+	}
+
+	if name != "" {
+		return IndexResponse{}, errors.WithStack(shared.InvalidArgumentError{
+			Message: fmt.Sprintf("collection '%s' has no geo index '%s'", c.name, name),
+		})
+	}
+	return IndexResponse{}, errors.WithStack(shared.InvalidArgumentError{
+		Message: fmt.Sprintf("collection '%s' has no geo index", c.name),
+	})
+}
+
+// geoDistanceExpression returns the AQL expression which computes the distance between the document `d`
+// and the point given by the `@latitude` and `@longitude` bind parameters, according to the fields of the geo index.
+func geoDistanceExpression(index IndexResponse) string {
+	fields := index.RegularIndex.Fields
+	if len(fields) >= 2 {
+		// The fields contain the latitude and the longitude.
+		return fmt.Sprintf("DISTANCE(%s, %s, @latitude, @longitude)", aqlAttributePath("d", fields[0]),
+			aqlAttributePath("d", fields[1]))
+	}
+
+	location := aqlAttributePath("d", fields[0])
+	if geoJSON := index.RegularIndex.GeoJSON; geoJSON != nil && *geoJSON {
+		// The location is a GeoJSON object or a [longitude, latitude] pair.
+		return fmt.Sprintf("GEO_DISTANCE([@longitude, @latitude], %s)", location)
+	}
+
+	// The location is a [latitude, longitude] pair.
+	return fmt.Sprintf("DISTANCE(%s[0], %s[1], @latitude, @longitude)", location, location)
+}
+
+// aqlAttributePath returns the AQL access to the given attribute path of the variable, e.g. "d.`a`.`b`" for "a.b".
+func aqlAttributePath(variable, path string) string {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		parts[i] = "`" + part + "`"
+	}
+	return variable + "." + strings.Join(parts, ".")
+}
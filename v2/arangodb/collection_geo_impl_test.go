@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_geoDistanceExpression(t *testing.T) {
+	tests := map[string]struct {
+		fields   []string
+		geoJSON  *bool
+		expected string
+	}{
+		"latitude and longitude fields": {
+			fields:   []string{"lat", "lon"},
+			expected: "DISTANCE(d.`lat`, d.`lon`, @latitude, @longitude)",
+		},
+		"location pair": {
+			fields:   []string{"position.location"},
+			geoJSON:  utils.NewType(false),
+			expected: "DISTANCE(d.`position`.`location`[0], d.`position`.`location`[1], @latitude, @longitude)",
+		},
+		"GeoJSON location": {
+			fields:   []string{"location"},
+			geoJSON:  utils.NewType(true),
+			expected: "GEO_DISTANCE([@longitude, @latitude], d.`location`)",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			index := IndexResponse{
+				Type:         GeoIndexType,
+				RegularIndex: &IndexOptions{Fields: tc.fields, GeoJSON: tc.geoJSON},
+			}
+			require.Equal(t, tc.expected, geoDistanceExpression(index))
+		})
+	}
+}
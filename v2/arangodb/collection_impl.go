@@ -22,8 +22,10 @@ package arangodb
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -118,6 +120,301 @@ func (c collection) Count(ctx context.Context) (int64, error) {
 	}
 }
 
+func (c collection) RecalculateCount(ctx context.Context) (int64, error) {
+	urlEndpoint := c.url("collection", "recalculateCount")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Count                 *int64 `json:"count,omitempty"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, struct{}{}, c.withModifiers()...)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		if response.Count == nil {
+			// Not all the server versions return the recalculated count.
+			return c.Count(ctx)
+		}
+		return *response.Count, nil
+	default:
+		return 0, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) Compact(ctx context.Context) error {
+	urlEndpoint := c.url("collection", "compact")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, struct{}{}, c.withModifiers()...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+// revisionAndCountAttempts is the maximum number of the attempts to read the revision and the count of a collection consistently.
+const revisionAndCountAttempts = 5
+
+func (c collection) RevisionAndCount(ctx context.Context) (CollectionRevisionAndCount, error) {
+	before, err := c.revision(ctx)
+	if err != nil {
+		return CollectionRevisionAndCount{}, err
+	}
+
+	for attempt := 0; attempt < revisionAndCountAttempts; attempt++ {
+		count, err := c.Count(ctx)
+		if err != nil {
+			return CollectionRevisionAndCount{}, err
+		}
+
+		after, err := c.revision(ctx)
+		if err != nil {
+			return CollectionRevisionAndCount{}, err
+		}
+
+		if before == after {
+			return CollectionRevisionAndCount{Revision: after, Count: count}, nil
+		}
+		before = after
+	}
+
+	return CollectionRevisionAndCount{}, errors.WithStack(CollectionChangingError{Collection: c.name, Attempts: revisionAndCountAttempts})
+}
+
+// revision returns the current revision of the collection.
+func (c collection) revision(ctx context.Context) (string, error) {
+	urlEndpoint := c.url("collection", "revision")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Revision              string `json:"revision"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.connection(), urlEndpoint, &response, c.withModifiers()...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Revision, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+// revisionTreeBatchTTL is the time to live of the replication batch, which is created to read the revision tree.
+// The batch is removed right after reading the tree, the TTL only limits its lifetime when the removal fails.
+const revisionTreeBatchTTL = time.Minute
+
+func (c collection) RevisionTreeSummary(ctx context.Context) (RevisionTreeSummary, error) {
+	// The revision tree can be read only within a replication batch.
+	batchID, err := c.createReplicationBatch(ctx, revisionTreeBatchTTL)
+	if err != nil {
+		return RevisionTreeSummary{}, err
+	}
+	defer c.removeReplicationBatch(context.Background(), batchID)
+
+	urlEndpoint := c.db.url("_api", "replication", "revisions", "tree")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		RevisionTreeSummary   `json:",inline"`
+		Nodes                 []struct {
+			Count uint64 `json:"count"`
+		} `json:"nodes"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.connection(), urlEndpoint, &response, c.withModifiers(
+		connection.WithQuery("collection", c.name), connection.WithQuery("batchId", batchID))...)
+	if err != nil {
+		return RevisionTreeSummary{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		summary := response.RevisionTreeSummary
+		summary.Leaves = len(response.Nodes)
+		for _, node := range response.Nodes {
+			if node.Count > 0 {
+				summary.PopulatedLeaves++
+			}
+		}
+		return summary, nil
+	default:
+		return RevisionTreeSummary{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) DocumentRevisions(ctx context.Context, key string) ([]map[string]interface{}, error) {
+	role, err := c.db.client.ServerRole(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if role == ServerRoleCoordinator || role == ServerRoleAgent {
+		return nil, errors.WithStack(shared.ArangoError{
+			HasError:     true,
+			Code:         http.StatusPreconditionFailed,
+			ErrorMessage: fmt.Sprintf("DB-Server or single server expected, found %s", role),
+		})
+	}
+
+	// The revision tree can be read only within a replication batch.
+	batchID, err := c.createReplicationBatch(ctx, revisionTreeBatchTTL)
+	if err != nil {
+		return nil, err
+	}
+	defer c.removeReplicationBatch(context.Background(), batchID)
+
+	meta, err := c.ReadDocument(ctx, key, nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			return []map[string]interface{}{}, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	revisions, err := c.revisionsInRange(ctx, batchID, meta.Rev, meta.Rev)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := c.revisionDocuments(ctx, batchID, revisions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, 0, len(documents))
+	for _, document := range documents {
+		if k, ok := document["_key"].(string); ok && k == key {
+			result = append(result, document)
+		}
+	}
+
+	return result, nil
+}
+
+// revisionsInRange returns the revisions between min and max (inclusive) which are known to the revision tree.
+func (c collection) revisionsInRange(ctx context.Context, batchID, min, max string) ([]string, error) {
+	urlEndpoint := c.db.url("_api", "replication", "revisions", "ranges")
+
+	var revisions []string
+	var resume string
+	for {
+		var response struct {
+			shared.ResponseStruct `json:",inline"`
+			Ranges                [][]string `json:"ranges"`
+			Resume                string     `json:"resume,omitempty"`
+		}
+
+		modifiers := []connection.RequestModifier{
+			connection.WithQuery("collection", c.name),
+			connection.WithQuery("batchId", batchID),
+		}
+		if resume != "" {
+			modifiers = append(modifiers, connection.WithQuery("resume", resume))
+		}
+
+		resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, [][]string{{min, max}},
+			c.withModifiers(modifiers...)...)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		switch code := resp.Code(); code {
+		case http.StatusOK:
+			for _, r := range response.Ranges {
+				revisions = append(revisions, r...)
+			}
+		default:
+			return nil, response.AsArangoErrorWithCode(code)
+		}
+
+		if response.Resume == "" {
+			return revisions, nil
+		}
+		resume = response.Resume
+	}
+}
+
+// revisionDocuments returns the documents stored for the given revisions.
+func (c collection) revisionDocuments(ctx context.Context, batchID string, revisions []string) ([]map[string]interface{}, error) {
+	if len(revisions) == 0 {
+		return nil, nil
+	}
+
+	urlEndpoint := c.db.url("_api", "replication", "revisions", "documents")
+
+	// The response is an array of the documents, so the error is decoded only for the unexpected status codes.
+	var documents []map[string]interface{}
+	_, err := connection.CallWithChecks(ctx, c.connection(), http.MethodPut, urlEndpoint, &documents,
+		[]int{http.StatusOK}, c.withModifiers(connection.WithBody(revisions),
+			connection.WithQuery("collection", c.name), connection.WithQuery("batchId", batchID))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return documents, nil
+}
+
+// createReplicationBatch creates a replication batch in the database of the collection and returns its ID.
+func (c collection) createReplicationBatch(ctx context.Context, ttl time.Duration) (string, error) {
+	urlEndpoint := c.db.url("_api", "replication", "batch")
+
+	request := struct {
+		TTL float64 `json:"ttl"`
+	}{
+		TTL: ttl.Seconds(),
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ID                    string `json:"id"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.connection(), urlEndpoint, &response, &request, c.withModifiers()...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ID, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+// removeReplicationBatch removes the replication batch with the given ID.
+func (c collection) removeReplicationBatch(ctx context.Context, id string) error {
+	urlEndpoint := c.db.url("_api", "replication", "batch", id)
+
+	resp, err := connection.CallDelete(ctx, c.connection(), urlEndpoint, nil, c.withModifiers()...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
+
 func (c collection) Properties(ctx context.Context) (CollectionProperties, error) {
 	urlEndpoint := c.url("collection", "properties")
 
@@ -159,10 +456,70 @@ func (c collection) SetProperties(ctx context.Context, options SetCollectionProp
 	}
 }
 
+func (c *collection) Rename(ctx context.Context, newName string) error {
+	urlEndpoint := c.url("collection", "rename")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Name                  string `json:"name,omitempty"`
+	}
+
+	body := struct {
+		Name string `json:"name"`
+	}{
+		Name: newName,
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, body, c.withModifiers()...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		c.name = newName
+		if response.Name != "" {
+			c.name = response.Name
+		}
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *collection) Unload(ctx context.Context) error {
+	engine, err := c.db.EngineInfo(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if engine.Type != EngineTypeMMFiles {
+		return errors.WithStack(EngineNotSupportedError{Engine: engine.Type, Operation: "unload"})
+	}
+
+	var response shared.ResponseStruct
+
+	resp, err := connection.CallPut(ctx, c.connection(), c.url("collection", "unload"), &response, struct{}{}, c.withModifiers()...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (c collection) Name() string {
 	return c.name
 }
 
+func (c collection) DocumentID(key string) string {
+	return c.name + "/" + key
+}
+
 func (c collection) Database() Database {
 	return c.db
 }
@@ -216,6 +573,150 @@ func (c collection) Shards(ctx context.Context, details bool) (CollectionShards,
 	}
 }
 
+func (c collection) ResponsibleShard(ctx context.Context, document interface{}) (ShardID, error) {
+	role, err := c.db.client.ServerRole(ctx)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if role != ServerRoleCoordinator {
+		// The collection is not sharded.
+		return ShardID(c.name), nil
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ShardID               ShardID `json:"shardId"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), c.url("collection", "responsibleShard"), &response,
+		document, c.withModifiers()...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ShardID, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+// collectionShardServers describes the servers of a single shard in the shard distribution.
+type collectionShardServers struct {
+	Leader    ServerID   `json:"leader"`
+	Followers []ServerID `json:"followers"`
+}
+
+// inSync returns true if the current servers of the shard are the same as the planned ones.
+func (s collectionShardServers) inSync(current collectionShardServers) bool {
+	if s.Leader != current.Leader || len(s.Followers) != len(current.Followers) {
+		return false
+	}
+
+	for _, follower := range s.Followers {
+		found := false
+		for _, c := range current.Followers {
+			if c == follower {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c collection) WaitForSync(ctx context.Context, timeout time.Duration) error {
+	role, err := c.db.client.ServerRole(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if role != ServerRoleCoordinator {
+		// Shards are not replicated.
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lagging []ShardID
+	for {
+		shards, err := c.laggingShards(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				return errors.WithStack(err)
+			}
+		} else if len(shards) == 0 {
+			return nil
+		} else {
+			lagging = shards
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(CollectionNotInSyncError{
+				Collection: c.name,
+				Shards:     lagging,
+			})
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// laggingShards returns the shards which current servers differ from the planned ones.
+func (c collection) laggingShards(ctx context.Context) ([]ShardID, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Results               map[string]struct {
+			Plan    map[ShardID]collectionShardServers `json:"Plan"`
+			Current map[ShardID]collectionShardServers `json:"Current"`
+		} `json:"results"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.connection(), c.db.url("_admin", "cluster", "shardDistribution"), &response, c.withModifiers()...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// Fallthrough.
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+
+	distribution, ok := response.Results[c.name]
+	if !ok {
+		return nil, errors.WithStack(shared.ArangoError{
+			HasError:     true,
+			Code:         http.StatusNotFound,
+			ErrorMessage: fmt.Sprintf("collection '%s' not found in the shard distribution", c.name),
+		})
+	}
+
+	var lagging []ShardID
+	for shard, plan := range distribution.Plan {
+		if current, ok := distribution.Current[shard]; !ok || !plan.inSync(current) {
+			lagging = append(lagging, shard)
+		}
+	}
+
+	return lagging, nil
+}
+
+// withWriteConcernError wraps the given error with the collection name
+// when it indicates that the write concern was not fulfilled.
+func withWriteConcernError(col string, err error) error {
+	if err == nil || !shared.IsWriteConcernNotMet(err) {
+		return err
+	}
+	return WriteConcernNotMetError{Collection: col, Err: err}
+}
+
 type RemoveCollectionOptions struct {
 	// IsSystem when set to true allows to remove system collections.
 	// Use on your own risk!
@@ -0,0 +1,202 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func Test_collectionShardServers_inSync(t *testing.T) {
+	plan := collectionShardServers{Leader: "A", Followers: []ServerID{"B", "C"}}
+
+	require.True(t, plan.inSync(collectionShardServers{Leader: "A", Followers: []ServerID{"C", "B"}}))
+	require.False(t, plan.inSync(collectionShardServers{Leader: "A", Followers: []ServerID{"B"}}))
+	require.False(t, plan.inSync(collectionShardServers{Leader: "B", Followers: []ServerID{"A", "C"}}))
+	require.True(t, collectionShardServers{Leader: "A"}.inSync(collectionShardServers{Leader: "A", Followers: []ServerID{}}))
+}
+
+func Test_CollectionNotInSyncError(t *testing.T) {
+	err := CollectionNotInSyncError{Collection: "col", Shards: []ShardID{"s2", "s1"}}
+
+	require.Equal(t, "collection 'col' is not in sync, lagging shards: s1, s2", err.Error())
+	require.True(t, IsCollectionNotInSync(err))
+}
+
+func Test_collection_DocumentID(t *testing.T) {
+	col := collection{name: "users"}
+
+	require.Equal(t, "users/john", col.DocumentID("john"))
+}
+
+func Test_collection_RevisionTreeSummary(t *testing.T) {
+	var removed bool
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/_db/db/_api/replication/batch":
+			w.Write([]byte(`{"id":"42","lastTick":"100"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/replication/revisions/tree":
+			require.Equal(t, "col", r.URL.Query().Get("collection"))
+			require.Equal(t, "42", r.URL.Query().Get("batchId"))
+			w.Write([]byte(`{"version":1,"maxDepth":1,"rangeMin":"_aaa","rangeMax":"_bbb","initialRangeMin":"_aaa",
+				"count":3,"hash":18446744073709551615,"nodes":[{"count":2,"hash":1},{"count":0,"hash":0},{"count":1,"hash":2}]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/_db/db/_api/replication/batch/42":
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	summary, err := col.RevisionTreeSummary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, RevisionTreeSummary{
+		Version:         1,
+		MaxDepth:        1,
+		RangeMin:        "_aaa",
+		RangeMax:        "_bbb",
+		InitialRangeMin: "_aaa",
+		Count:           3,
+		Hash:            18446744073709551615,
+		Leaves:          3,
+		PopulatedLeaves: 2,
+	}, summary)
+	require.True(t, removed, "the replication batch is not removed")
+}
+
+func Test_collection_RevisionAndCount(t *testing.T) {
+	// revisions are returned by the consecutive revision requests, the count is increased together with the revision.
+	newCollection := func(revisions ...string) Collection {
+		var next int
+		return newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/collection/col/revision":
+				revision := revisions[len(revisions)-1]
+				if next < len(revisions) {
+					revision = revisions[next]
+				}
+				next++
+				w.Write([]byte(`{"revision":"` + revision + `"}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/collection/col/count":
+				w.Write([]byte(`{"count":` + strconv.Itoa(10+next) + `}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	}
+
+	t.Run("unchanged collection", func(t *testing.T) {
+		result, err := newCollection("100").RevisionAndCount(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, CollectionRevisionAndCount{Revision: "100", Count: 11}, result)
+	})
+
+	t.Run("collection changed during the first attempt", func(t *testing.T) {
+		result, err := newCollection("100", "101").RevisionAndCount(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, CollectionRevisionAndCount{Revision: "101", Count: 12}, result)
+	})
+
+	t.Run("collection keeps changing", func(t *testing.T) {
+		_, err := newCollection("1", "2", "3", "4", "5", "6", "7").RevisionAndCount(context.Background())
+		require.True(t, IsCollectionChanging(err))
+
+		var changing CollectionChangingError
+		require.ErrorAs(t, err, &changing)
+		require.Equal(t, CollectionChangingError{Collection: "col", Attempts: revisionAndCountAttempts}, changing)
+	})
+}
+
+func Test_collection_DocumentRevisions(t *testing.T) {
+	role := "PRIMARY"
+	var removed bool
+	var rangeRequests []string
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/_admin/server/role":
+			w.Write([]byte(`{"role":"` + role + `"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/_db/db/_api/replication/batch":
+			w.Write([]byte(`{"id":"42","lastTick":"100"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/document/col/k":
+			w.Write([]byte(`{"_key":"k","_id":"col/k","_rev":"_rev2"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/document/col/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":true,"code":404,"errorNum":1202,"errorMessage":"document not found"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/_db/db/_api/replication/revisions/ranges":
+			require.Equal(t, "col", r.URL.Query().Get("collection"))
+			require.Equal(t, "42", r.URL.Query().Get("batchId"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `[["_rev2","_rev2"]]`, string(body), "only the range of the current revision is listed")
+			rangeRequests = append(rangeRequests, r.URL.Query().Get("resume"))
+			if r.URL.Query().Get("resume") == "" {
+				w.Write([]byte(`{"ranges":[[]],"resume":"_rev2"}`))
+				return
+			}
+			w.Write([]byte(`{"ranges":[["_rev2"]]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/_db/db/_api/replication/revisions/documents":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `["_rev2"]`, string(body))
+			w.Write([]byte(`[{"_key":"k","_rev":"_rev2","age":42}]`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/_db/db/_api/replication/batch/42":
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	t.Run("revisions of the document", func(t *testing.T) {
+		revisions, err := col.DocumentRevisions(context.Background(), "k")
+		require.NoError(t, err)
+		require.Equal(t, []map[string]interface{}{{"_key": "k", "_rev": "_rev2", "age": float64(42)}}, revisions)
+		require.Equal(t, []string{"", "_rev2"}, rangeRequests)
+		require.True(t, removed, "the replication batch is not removed")
+	})
+
+	t.Run("missing document", func(t *testing.T) {
+		revisions, err := col.DocumentRevisions(context.Background(), "missing")
+		require.NoError(t, err)
+		require.Empty(t, revisions)
+	})
+
+	t.Run("coordinator", func(t *testing.T) {
+		role = "COORDINATOR"
+
+		_, err := col.DocumentRevisions(context.Background(), "k")
+		require.True(t, shared.IsPreconditionFailed(err))
+	})
+}
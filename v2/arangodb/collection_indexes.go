@@ -71,6 +71,11 @@ type CollectionIndexes interface {
 	// Available in ArangoDB 3.12 and later.
 	EnsureMDIIndex(ctx context.Context, fields []string, options *CreateMDIIndexOptions) (IndexResponse, bool, error)
 
+	// EnsureMDIndex creates a multidimensional index for the collection, if it does not already exist.
+	// The index type is chosen based on the server version: `zkd` before ArangoDB 3.12, `mdi` in 3.12 and later.
+	// The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
+	EnsureMDIndex(ctx context.Context, fields []string, options *CreateMDIIndexOptions) (IndexResponse, bool, error)
+
 	// EnsureMDIPrefixedIndex creates is an additional index variant of mdi index that lets you specify additional
 	// attributes for the index to narrow down the search space using equality checks.
 	// Available in ArangoDB 3.12 and later.
@@ -166,6 +171,16 @@ type IndexResponse struct {
 	InvertedIndex *InvertedIndexOptions `json:"invertedIndexes"`
 }
 
+// ExpireAfter returns the number of seconds after which the documents expire, relative to the indexed date.
+// It returns false if the index is not a TTL index.
+func (i IndexResponse) ExpireAfter() (int, bool) {
+	if i.Type != TTLIndexType || i.RegularIndex == nil || i.RegularIndex.ExpireAfter == nil {
+		return 0, false
+	}
+
+	return *i.RegularIndex.ExpireAfter, true
+}
+
 // IndexSharedOptions contains options that are shared between all index types
 type IndexSharedOptions struct {
 	// ID returns the ID of the index. Effectively this is `<collection-name>/<index.Name()>`.
@@ -191,6 +191,32 @@ func (c *collectionIndexes) EnsureMDIIndex(ctx context.Context, fields []string,
 	return newIndexResponse(&result), exist, err
 }
 
+func (c *collectionIndexes) EnsureMDIndex(ctx context.Context, fields []string, options *CreateMDIIndexOptions) (IndexResponse, bool, error) {
+	version, err := c.collection.db.client.Version(ctx)
+	if err != nil {
+		return IndexResponse{}, false, errors.WithStack(err)
+	}
+
+	indexType := MDIIndexType
+	if !version.Version.SupportsFeature(FeatureMDIIndex) {
+		indexType = ZKDIndexType
+	}
+
+	reqData := struct {
+		Type   IndexType `json:"type"`
+		Fields []string  `json:"fields"`
+		*CreateMDIIndexOptions
+	}{
+		Type:                  indexType,
+		Fields:                fields,
+		CreateMDIIndexOptions: options,
+	}
+
+	result := responseIndex{}
+	exist, err := c.ensureIndex(ctx, &reqData, &result)
+	return newIndexResponse(&result), exist, err
+}
+
 func (c *collectionIndexes) EnsureMDIPrefixedIndex(ctx context.Context, fields []string, options *CreateMDIPrefixedIndexOptions) (IndexResponse, bool, error) {
 	reqData := struct {
 		Type   IndexType `json:"type"`
@@ -307,6 +333,30 @@ func newInvertedIndexResponse(res *responseInvertedIndex) IndexResponse {
 	}
 }
 
+// MarshalJSON serializes the index in the same format as it is returned by the server,
+// so it can be read back with UnmarshalJSON.
+func (i IndexResponse) MarshalJSON() ([]byte, error) {
+	if i.InvertedIndex != nil {
+		return json.Marshal(responseInvertedIndex{
+			Name:                 i.Name,
+			Type:                 i.Type,
+			IndexSharedOptions:   i.IndexSharedOptions,
+			InvertedIndexOptions: *i.InvertedIndex,
+		})
+	}
+
+	result := responseIndex{
+		Name:               i.Name,
+		Type:               i.Type,
+		IndexSharedOptions: i.IndexSharedOptions,
+	}
+	if i.RegularIndex != nil {
+		result.IndexOptions = *i.RegularIndex
+	}
+
+	return json.Marshal(result)
+}
+
 func (i *IndexResponse) UnmarshalJSON(data []byte) error {
 	var respSimple struct {
 		Type IndexType `json:"type"`
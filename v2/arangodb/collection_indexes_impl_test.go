@@ -0,0 +1,63 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IndexResponse_JSON(t *testing.T) {
+	t.Run("regular index", func(t *testing.T) {
+		index := IndexResponse{
+			Name:               "idx",
+			Type:               PersistentIndexType,
+			IndexSharedOptions: IndexSharedOptions{ID: "col/1"},
+			RegularIndex:       &IndexOptions{Fields: []string{"name"}},
+		}
+
+		data, err := json.Marshal(index)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id": "col/1", "name": "idx", "type": "persistent", "fields": ["name"]}`, string(data))
+
+		var restored IndexResponse
+		require.NoError(t, json.Unmarshal(data, &restored))
+		require.Equal(t, index, restored)
+	})
+
+	t.Run("inverted index", func(t *testing.T) {
+		index := IndexResponse{
+			Name:          "inv",
+			Type:          InvertedIndexType,
+			InvertedIndex: &InvertedIndexOptions{Fields: []InvertedIndexField{{Name: "name"}}},
+		}
+
+		data, err := json.Marshal(index)
+		require.NoError(t, err)
+
+		var restored IndexResponse
+		require.NoError(t, json.Unmarshal(data, &restored))
+		require.Equal(t, index.InvertedIndex.Fields, restored.InvertedIndex.Fields)
+		require.Nil(t, restored.RegularIndex)
+	})
+}
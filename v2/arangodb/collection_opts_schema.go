@@ -35,10 +35,15 @@ const (
 	CollectionSchemaLevelStrict   CollectionSchemaLevel = "strict"
 )
 
+// CollectionSchemaOptions describes the JSON Schema validation of the collection documents.
+// The documents which do not match the schema are rejected with an error detected by shared.IsSchemaValidationFailed.
 type CollectionSchemaOptions struct {
-	Rule    interface{}           `json:"rule,omitempty"`
-	Level   CollectionSchemaLevel `json:"level,omitempty"`
-	Message string                `json:"message,omitempty"`
+	// Rule is the JSON Schema description of the documents.
+	Rule interface{} `json:"rule,omitempty"`
+	// Level controls when the documents are validated.
+	Level CollectionSchemaLevel `json:"level,omitempty"`
+	// Message is the error message returned when the validation fails.
+	Message string `json:"message,omitempty"`
 }
 
 func (d *CollectionSchemaOptions) LoadRule(data []byte) error {
@@ -0,0 +1,150 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+type contextKey string
+
+const (
+	keyLockTimeout        contextKey = "arangodb-lock-timeout"
+	keyMaxTransactionSize contextKey = "arangodb-max-transaction-size"
+	keyAllowDirtyRead     contextKey = "arangodb-allow-dirty-read"
+)
+
+// WithLockTimeout is used to configure a context to set the timeout for waiting on the collection locks
+// of the document write operations (create, update, replace, delete and import).
+// It is not applied to Collection.Upsert, because the AQL queries do not support it.
+func WithLockTimeout(parent context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyLockTimeout, timeout)
+}
+
+// WithMaxTransactionSize is used to configure a context to limit the size in bytes of the implicit transaction
+// of the document write operations (create, update, replace, delete, import and upsert).
+func WithMaxTransactionSize(parent context.Context, size uint64) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyMaxTransactionSize, size)
+}
+
+// WithAllowDirtyRead is used to configure a context to allow the document reads and the queries
+// to be served by the followers in a cluster. This may result in “dirty reads”.
+// Use WasDirtyRead with the returned context to check whether the last response was potentially a dirty read.
+// The AllowDirtyReads field of the operation options takes precedence over the context.
+func WithAllowDirtyRead(parent context.Context) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyAllowDirtyRead, &dirtyReadFlag{})
+}
+
+// WasDirtyRead returns true if the last read performed with the given context, configured with WithAllowDirtyRead,
+// has been served by a shard replica which may not be in sync with the leader.
+func WasDirtyRead(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	if flag, ok := ctx.Value(keyAllowDirtyRead).(*dirtyReadFlag); ok {
+		return flag.value.Load()
+	}
+	return false
+}
+
+// dirtyReadFlag keeps the result of the last read performed with a context configured with WithAllowDirtyRead.
+type dirtyReadFlag struct {
+	value atomic.Bool
+}
+
+// contextOrBackground returns the given context if it is not nil.
+// Returns context.Background() otherwise.
+func contextOrBackground(ctx context.Context) context.Context {
+	if ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// withTransactionLimits adds the lock timeout and the max transaction size from the context to the request.
+func withTransactionLimits(ctx context.Context) connection.RequestModifier {
+	return func(r connection.Request) error {
+		if ctx == nil {
+			return nil
+		}
+
+		if timeout, ok := ctx.Value(keyLockTimeout).(time.Duration); ok {
+			r.AddQuery("lockTimeout", strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64))
+		}
+
+		if size, ok := ctx.Value(keyMaxTransactionSize).(uint64); ok {
+			r.AddQuery("maxTransactionSize", strconv.FormatUint(size, 10))
+		}
+
+		return nil
+	}
+}
+
+// withQueryTransactionLimits sets the max transaction size from the context in the options of the query.
+// The lock timeout can not be set for the queries.
+func withQueryTransactionLimits(ctx context.Context, options *QuerySubOptions) {
+	if ctx == nil {
+		return
+	}
+
+	if size, ok := ctx.Value(keyMaxTransactionSize).(uint64); ok {
+		options.MaxTransactionSize = utils.NewType(int(size))
+	}
+}
+
+// withDirtyRead adds the dirty read header to the request when it is allowed in the context.
+// The result of the previous read is cleared, so a failed read is not reported as a dirty read.
+func withDirtyRead(ctx context.Context) connection.RequestModifier {
+	return func(r connection.Request) error {
+		if ctx == nil {
+			return nil
+		}
+
+		if flag, ok := ctx.Value(keyAllowDirtyRead).(*dirtyReadFlag); ok {
+			flag.value.Store(false)
+			r.AddHeader(HeaderDirtyReads, "true")
+		}
+
+		return nil
+	}
+}
+
+// isDirtyReadResponse returns true if the response was potentially a dirty read.
+func isDirtyReadResponse(resp connection.Response) bool {
+	return resp != nil && resp.Header(HeaderPotentialDirtyRead) == "true"
+}
+
+// readDirtyReadResponse stores in the context whether the response was potentially a dirty read.
+func readDirtyReadResponse(ctx context.Context, resp connection.Response) {
+	if ctx == nil || resp == nil {
+		return
+	}
+
+	if flag, ok := ctx.Value(keyAllowDirtyRead).(*dirtyReadFlag); ok {
+		flag.value.Store(isDirtyReadResponse(resp))
+	}
+}
@@ -0,0 +1,196 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_withTransactionLimits(t *testing.T) {
+	recorder := &requestRecorder{}
+	col := newTestCollection(t, recorder.ServeHTTP)
+
+	ctx := WithMaxTransactionSize(WithLockTimeout(context.Background(), 1500*time.Millisecond), 1024)
+
+	operations := map[string]struct {
+		code int
+		body string
+		run  func(ctx context.Context) error
+	}{
+		"create": {http.StatusCreated, `{"_key":"k"}`, func(ctx context.Context) error {
+			_, err := col.CreateDocument(ctx, map[string]string{"_key": "k"})
+			return err
+		}},
+		"create many": {http.StatusCreated, `[]`, func(ctx context.Context) error {
+			_, err := col.CreateDocuments(ctx, []map[string]string{{"_key": "k"}})
+			return err
+		}},
+		"update": {http.StatusCreated, `{"_key":"k"}`, func(ctx context.Context) error {
+			_, err := col.UpdateDocument(ctx, "k", map[string]string{"a": "b"})
+			return err
+		}},
+		"replace": {http.StatusCreated, `{"_key":"k"}`, func(ctx context.Context) error {
+			_, err := col.ReplaceDocument(ctx, "k", map[string]string{"a": "b"})
+			return err
+		}},
+		"delete": {http.StatusOK, `{"_key":"k"}`, func(ctx context.Context) error {
+			_, err := col.DeleteDocument(ctx, "k")
+			return err
+		}},
+		"import": {http.StatusCreated, `{"created":1}`, func(ctx context.Context) error {
+			_, err := col.ImportFromReader(ctx, strings.NewReader(`{"_key":"k"}`), nil)
+			return err
+		}},
+	}
+
+	for name, op := range operations {
+		t.Run(name, func(t *testing.T) {
+			recorder.code, recorder.body = op.code, op.body
+
+			recorder.queries = nil
+			require.NoError(t, op.run(ctx))
+			require.Len(t, recorder.queries, 1)
+			require.Equal(t, "1.5", recorder.queries[0].Get("lockTimeout"))
+			require.Equal(t, "1024", recorder.queries[0].Get("maxTransactionSize"))
+
+			recorder.queries = nil
+			require.NoError(t, op.run(context.Background()))
+			require.Len(t, recorder.queries, 1)
+			require.False(t, recorder.queries[0].Has("lockTimeout"))
+			require.False(t, recorder.queries[0].Has("maxTransactionSize"))
+		})
+	}
+}
+
+func Test_withQueryTransactionLimits(t *testing.T) {
+	var options []map[string]interface{}
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Options map[string]interface{} `json:"options"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		options = append(options, body.Options)
+
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"error":false,"code":201,"result":[{"_key":"k","inserted":true}],"hasMore":false}`))
+	})
+
+	ctx := WithMaxTransactionSize(WithLockTimeout(context.Background(), time.Second), 1024)
+	_, err := col.Upsert(ctx, "k", map[string]string{}, map[string]string{}, nil)
+	require.NoError(t, err)
+
+	_, err = col.Upsert(context.Background(), "k", map[string]string{}, map[string]string{}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, options, 2)
+	require.EqualValues(t, 1024, options[0]["maxTransactionSize"])
+	require.NotContains(t, options[1], "maxTransactionSize")
+}
+
+func Test_WithAllowDirtyRead(t *testing.T) {
+	var dirtyReadHeaders []string
+	db := newTestDatabase(t, func(w http.ResponseWriter, r *http.Request) {
+		dirtyReadHeaders = append(dirtyReadHeaders, r.Header.Get(HeaderDirtyReads))
+
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		if r.Header.Get(HeaderDirtyReads) == "true" {
+			w.Header().Set(HeaderPotentialDirtyRead, "true")
+		}
+
+		switch r.URL.Path {
+		case "/_db/db/_api/document/col/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":true,"code":404,"errorNum":1202,"errorMessage":"document not found"}`))
+		case "/_db/db/_api/cursor":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"error":false,"code":201,"result":[],"hasMore":false}`))
+		default:
+			w.Write([]byte(`{"_key":"k","_id":"col/k","_rev":"1"}`))
+		}
+	})
+
+	col, err := db.GetCollection(context.Background(), "col", &GetCollectionOptions{SkipExistCheck: true})
+	require.NoError(t, err)
+
+	operations := map[string]func(ctx context.Context) error{
+		"read": func(ctx context.Context) error {
+			_, err := col.ReadDocument(ctx, "k", nil)
+			return err
+		},
+		"query": func(ctx context.Context) error {
+			_, err := db.Query(ctx, "RETURN 1", nil)
+			return err
+		},
+	}
+
+	for name, op := range operations {
+		t.Run(name, func(t *testing.T) {
+			ctx := WithAllowDirtyRead(context.Background())
+			require.False(t, WasDirtyRead(ctx))
+
+			dirtyReadHeaders = nil
+			require.NoError(t, op(ctx))
+			require.Equal(t, []string{"true"}, dirtyReadHeaders)
+			require.True(t, WasDirtyRead(ctx))
+
+			dirtyReadHeaders = nil
+			require.NoError(t, op(context.Background()))
+			require.Equal(t, []string{""}, dirtyReadHeaders)
+			require.False(t, WasDirtyRead(context.Background()))
+		})
+	}
+
+	t.Run("failed read clears the flag", func(t *testing.T) {
+		ctx := WithAllowDirtyRead(context.Background())
+
+		_, err := col.ReadDocument(ctx, "k", nil)
+		require.NoError(t, err)
+		require.True(t, WasDirtyRead(ctx))
+
+		_, err = col.ReadDocument(ctx, "missing", nil)
+		require.True(t, shared.IsNotFound(err))
+		require.False(t, WasDirtyRead(ctx))
+	})
+
+	t.Run("options take precedence", func(t *testing.T) {
+		ctx := WithAllowDirtyRead(context.Background())
+
+		dirtyReadHeaders = nil
+		_, err := col.ReadDocumentWithOptions(ctx, "k", nil, &CollectionDocumentReadOptions{
+			AllowDirtyReads: utils.NewType(false),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"false"}, dirtyReadHeaders)
+		require.False(t, WasDirtyRead(ctx))
+	})
+}
@@ -22,6 +22,7 @@ package arangodb
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 )
 
@@ -41,18 +42,33 @@ type Cursor interface {
 	// If the cursor has no more documents, a NoMoreDocuments error is returned.
 	// Note: If the query (resulting in this cursor) does not return documents,
 	//       then the returned DocumentMeta will be empty.
+	// The cursor reuses its internal decode buffer for every document. Passing the same result pointer
+	// to every call reuses its memory as well (e.g. the backing arrays of slices and the maps are reused),
+	// so a read loop allocates only for the data which can not be reused, e.g. strings.
+	// Reset the fields of result before a call if the documents do not contain all the fields.
 	ReadDocument(ctx context.Context, result interface{}) (DocumentMeta, error)
 
-	// Count returns the total number of result documents available.
-	// A valid return value is only available when the cursor has been created with `Count` and not with `Stream`.
+	// Count returns the total number of result documents of the query, regardless of the current batch.
+	// It is only available when the query has been executed with the `Count` option and not with `Stream`,
+	// otherwise -1 is returned.
 	Count() int64
 
+	// FullCount returns the number of documents which matched the query if the final LIMIT statement were not present.
+	// It is only available when the query has been executed with the `FullCount` option, otherwise -1 is returned.
+	FullCount() int64
+
 	// Statistics returns the query execution statistics for this cursor.
 	// This might not be valid if the cursor has been created with `Stream`
 	Statistics() CursorStats
 
 	// Plan returns the query execution plan for this cursor.
 	Plan() CursorPlan
+
+	// Cached returns true if the query result has been served from the AQL query results cache.
+	Cached() bool
+
+	// Warnings returns the warnings produced by the query.
+	Warnings() []QueryWarning
 }
 
 // CursorBatch is returned from a query, used to iterate over a list of documents.
@@ -77,16 +93,27 @@ type CursorBatch interface {
 	// E.g. `var result []MyStruct{}`.
 	RetryReadBatch(ctx context.Context, result interface{}) error
 
-	// Count returns the total number of result documents available.
-	// A valid return value is only available when the cursor has been created with `Count` and not with `Stream`.
+	// Count returns the total number of result documents of the query, regardless of the current batch.
+	// It is only available when the query has been executed with the `Count` option and not with `Stream`,
+	// otherwise -1 is returned.
 	Count() int64
 
+	// FullCount returns the number of documents which matched the query if the final LIMIT statement were not present.
+	// It is only available when the query has been executed with the `FullCount` option, otherwise -1 is returned.
+	FullCount() int64
+
 	// Statistics returns the query execution statistics for this cursor.
 	// This might not be valid if the cursor has been created with `Stream`
 	Statistics() CursorStats
 
 	// Plan returns the query execution plan for this cursor.
 	Plan() CursorPlan
+
+	// Cached returns true if the query result has been served from the AQL query results cache.
+	Cached() bool
+
+	// Warnings returns the warnings produced by the query.
+	Warnings() []QueryWarning
 }
 
 type CursorStats struct {
@@ -124,19 +151,50 @@ type CursorStats struct {
 	CacheMisses uint64 `json:"cacheMisses,omitempty"`
 }
 
+// cursorStats keeps track of whether the fullCount statistic has been returned by the server.
+type cursorStats struct {
+	CursorStats
+	fullCount *int64
+}
+
+func (c *cursorStats) UnmarshalJSON(data []byte) error {
+	var fc struct {
+		FullCount *int64 `json:"fullCount,omitempty"`
+	}
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &c.CursorStats); err != nil {
+		return err
+	}
+	c.fullCount = fc.FullCount
+	return nil
+}
+
 type cursorData struct {
-	Count       int64      `json:"count,omitempty"`       // the total number of result documents available (only available if the query was executed with the count attribute set)
+	Count       *int64     `json:"count,omitempty"`       // the total number of result documents available (only available if the query was executed with the count attribute set)
 	ID          string     `json:"id"`                    // id of temporary cursor created on the server (optional, see above)
 	Result      jsonReader `json:"result,omitempty"`      // a stream of result documents (might be empty if query has no results)
 	NextBatchID string     `json:"nextBatchId,omitempty"` // id of the next batch of the cursor on the server when `allowRetry` option is true
 	HasMore     bool       `json:"hasMore,omitempty"`     // A boolean indicator whether there are more results available for the cursor on the server
+	Cached      bool       `json:"cached,omitempty"`      // A boolean flag indicating whether the query result was served from the query cache
 	Extra       struct {
-		Stats CursorStats `json:"stats,omitempty"`
+		Stats cursorStats `json:"stats,omitempty"`
 		// Plan describes plan for a cursor.
 		Plan CursorPlan `json:"plan,omitempty"`
+		// Warnings contains the warnings produced by the query.
+		Warnings []QueryWarning `json:"warnings,omitempty"`
 	} `json:"extra"`
 }
 
+// QueryWarning is a warning produced by the query.
+type QueryWarning struct {
+	// Code is the error number of the warning, e.g. 1562 for division by zero.
+	Code int `json:"code"`
+	// Message describes the warning.
+	Message string `json:"message"`
+}
+
 // CursorPlan describes execution plan for a query.
 type CursorPlan struct {
 	// Nodes describes a nested list of the execution plan nodes.
@@ -22,7 +22,6 @@ package arangodb
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"sync"
 
@@ -58,6 +57,10 @@ type cursor struct {
 	data      cursorData
 	lock      sync.Mutex
 	retryData *retryData
+
+	// buffer keeps the raw data of the last read document.
+	// Its memory is reused by the next reads, so the documents are not copied into new allocations.
+	buffer byteDecoder
 }
 
 type retryData struct {
@@ -85,7 +88,7 @@ func (c *cursor) CloseWithContext(ctx context.Context) error {
 
 	url := c.db.url("_api", "cursor", c.data.ID)
 
-	resp, err := connection.CallDelete(ctx, c.db.connection(), url, &c.data, c.db.modifiers...)
+	resp, err := connection.CallDelete(c.withEndpoint(ctx), c.db.connection(), url, &c.data, c.db.modifiers...)
 	if err != nil {
 		return err
 	}
@@ -121,7 +124,7 @@ func (c *cursor) ReadNextBatch(ctx context.Context, result interface{}) error {
 		return err
 	}
 
-	return json.Unmarshal(c.data.Result.in, result)
+	return c.data.Result.unmarshal(result)
 }
 
 func (c *cursor) RetryReadBatch(ctx context.Context, result interface{}) error {
@@ -130,7 +133,7 @@ func (c *cursor) RetryReadBatch(ctx context.Context, result interface{}) error {
 		return err
 	}
 
-	return json.Unmarshal(c.data.Result.in, result)
+	return c.data.Result.unmarshal(result)
 }
 
 func (c *cursor) readDocument(ctx context.Context, result interface{}) (DocumentMeta, error) {
@@ -144,18 +147,23 @@ func (c *cursor) readDocument(ctx context.Context, result interface{}) (Document
 		}
 	}
 
-	var data byteDecoder
-	if err := c.data.Result.Read(&data); err != nil {
+	return c.decodeDocument(&c.buffer, result)
+}
+
+// decodeDocument decodes the next document of the current batch into result.
+// The raw data of the document is stored in the given buffer.
+func (c *cursor) decodeDocument(buffer *byteDecoder, result interface{}) (DocumentMeta, error) {
+	if err := c.data.Result.Read(buffer); err != nil {
 		return DocumentMeta{}, err
 	}
 
 	var meta DocumentMeta
 
-	if err := data.Unmarshal(&meta); err != nil {
+	if err := buffer.Unmarshal(&meta); err != nil {
 		// Ignore error
 	}
 
-	if err := data.Unmarshal(result); err != nil {
+	if err := buffer.Unmarshal(result); err != nil {
 		return DocumentMeta{}, err
 	}
 
@@ -182,31 +190,84 @@ func (c *cursor) getNextBatch(ctx context.Context, retryBatchID string) error {
 		c.retryData.currentBatchID = c.data.NextBatchID
 	}
 
-	var data cursorData
+	// The batch can be fetched again when the cursor allows retries,
+	// so it is re-requested when the response is lost, e.g. because of a network error.
+	attempts := 1
+	if c.retryData != nil && retryBatchID == "" {
+		attempts += maxCursorBatchRetries
+	}
 
-	resp, err := connection.CallPost(ctx, c.db.connection(), url, &data, nil, c.db.modifiers...)
-	if err != nil {
-		return err
+	for attempt := 1; ; attempt++ {
+		var data cursorData
+
+		resp, err := connection.CallPost(c.withEndpoint(ctx), c.db.connection(), url, &data, nil, c.db.modifiers...)
+		if err != nil {
+			if attempt < attempts && ctx.Err() == nil {
+				continue
+			}
+			return err
+		}
+
+		switch code := resp.Code(); code {
+		case http.StatusOK:
+			// The counts describe the whole query, so keep them if the next batch does not repeat them.
+			if data.Count == nil {
+				data.Count = c.data.Count
+			}
+			if data.Extra.Stats.fullCount == nil {
+				data.Extra.Stats.fullCount = c.data.Extra.Stats.fullCount
+			}
+			c.data = data
+			return nil
+		default:
+			return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+		}
 	}
+}
 
-	switch code := resp.Code(); code {
-	case http.StatusOK:
-		c.data = data
-		return nil
-	default:
-		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+// maxCursorBatchRetries is the maximum number of times the lost batch is fetched again.
+const maxCursorBatchRetries = 3
+
+// withEndpoint pins the request to the endpoint on which the cursor has been created.
+func (c *cursor) withEndpoint(ctx context.Context) context.Context {
+	if c.endpoint == "" {
+		return ctx
 	}
+	if _, ok := connection.HasEndpoint(ctx); ok {
+		return ctx
+	}
+	return connection.WithEndpoint(ctx, c.endpoint)
 }
 
 func (c *cursor) Count() int64 {
-	return c.data.Count
+	if c.data.Count == nil {
+		return -1
+	}
+	return *c.data.Count
+}
+
+func (c *cursor) FullCount() int64 {
+	if c.data.Extra.Stats.fullCount == nil {
+		return -1
+	}
+	return *c.data.Extra.Stats.fullCount
 }
 
 func (c *cursor) Statistics() CursorStats {
-	return c.data.Extra.Stats
+	return c.data.Extra.Stats.CursorStats
 }
 
 // Plan returns the query execution plan for this cursor.
 func (c *cursor) Plan() CursorPlan {
 	return c.data.Extra.Plan
 }
+
+// Cached returns true if the query result has been served from the AQL query results cache.
+func (c *cursor) Cached() bool {
+	return c.data.Cached
+}
+
+// Warnings returns the warnings produced by the query.
+func (c *cursor) Warnings() []QueryWarning {
+	return c.data.Extra.Warnings
+}
@@ -0,0 +1,230 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newDroppingCursorDatabase returns the database served by a server with a cursor of two batches.
+// The response of the second batch is dropped the given number of times.
+func newDroppingCursorDatabase(t *testing.T, allowRetry bool, drops int) (Database, func() int) {
+	var lock sync.Mutex
+	var requests int
+
+	db := newTestDatabase(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/_db/db/_api/cursor":
+			w.WriteHeader(http.StatusCreated)
+			if allowRetry {
+				w.Write([]byte(`{"id":"c1","hasMore":true,"nextBatchId":"2","result":[1,2]}`))
+			} else {
+				w.Write([]byte(`{"id":"c1","hasMore":true,"result":[1,2]}`))
+			}
+		case "/_db/db/_api/cursor/c1/2", "/_db/db/_api/cursor/c1":
+			lock.Lock()
+			requests++
+			drop := requests <= drops
+			lock.Unlock()
+
+			if drop {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				require.NoError(t, err)
+				conn.Close()
+				return
+			}
+			w.Write([]byte(`{"id":"c1","hasMore":false,"result":[3,4]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return db, func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return requests
+	}
+}
+
+func Test_cursor_RetryLostBatch(t *testing.T) {
+	readAll := func(db Database) ([]int, error) {
+		cursor, err := db.Query(context.Background(), "FOR i IN 1..4 RETURN i", &QueryOptions{
+			BatchSize: 2,
+			Options:   QuerySubOptions{AllowRetry: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []int
+		for cursor.HasMore() {
+			var i int
+			if _, err := cursor.ReadDocument(context.Background(), &i); err != nil {
+				return result, err
+			}
+			result = append(result, i)
+		}
+
+		return result, nil
+	}
+
+	t.Run("lost batch is fetched again", func(t *testing.T) {
+		db, requests := newDroppingCursorDatabase(t, true, 1)
+
+		result, err := readAll(db)
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3, 4}, result)
+		require.Equal(t, 2, requests())
+	})
+
+	t.Run("retries are limited", func(t *testing.T) {
+		db, requests := newDroppingCursorDatabase(t, true, maxCursorBatchRetries+1)
+
+		result, err := readAll(db)
+		require.Error(t, err)
+		require.Equal(t, []int{1, 2}, result)
+		require.Equal(t, maxCursorBatchRetries+1, requests())
+	})
+
+	t.Run("batch is not fetched again without retry support", func(t *testing.T) {
+		db, requests := newDroppingCursorDatabase(t, false, 1)
+
+		result, err := readAll(db)
+		require.Error(t, err)
+		require.Equal(t, []int{1, 2}, result)
+		require.Equal(t, 1, requests())
+	})
+}
+
+func Test_cursor_Counts(t *testing.T) {
+	query := func(t *testing.T, first string) Cursor {
+		db := newTestDatabase(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			switch r.URL.Path {
+			case "/_db/db/_api/cursor":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(first))
+			case "/_db/db/_api/cursor/c1":
+				w.Write([]byte(`{"id":"c1","hasMore":false,"result":[3]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		cursor, err := db.Query(context.Background(), "FOR i IN 1..10 LIMIT 3 RETURN i", nil)
+		require.NoError(t, err)
+		return cursor
+	}
+
+	readAll := func(t *testing.T, cursor Cursor) {
+		for cursor.HasMore() {
+			var i int
+			_, err := cursor.ReadDocument(context.Background(), &i)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("counts are not available", func(t *testing.T) {
+		cursor := query(t, `{"id":"c1","hasMore":true,"result":[1,2],"extra":{"stats":{"writesExecuted":0}}}`)
+
+		require.Equal(t, int64(-1), cursor.Count())
+		require.Equal(t, int64(-1), cursor.FullCount())
+	})
+
+	t.Run("counts are kept for the following batches", func(t *testing.T) {
+		cursor := query(t, `{"id":"c1","hasMore":true,"count":3,"result":[1,2],"extra":{"stats":{"fullCount":10}}}`)
+
+		require.Equal(t, int64(3), cursor.Count())
+		require.Equal(t, int64(10), cursor.FullCount())
+		require.Equal(t, uint64(10), cursor.Statistics().FullCountInt)
+
+		readAll(t, cursor)
+		require.Equal(t, int64(3), cursor.Count())
+		require.Equal(t, int64(10), cursor.FullCount())
+	})
+
+	t.Run("zero counts are available", func(t *testing.T) {
+		cursor := query(t, `{"id":"c1","hasMore":false,"count":0,"result":[],"extra":{"stats":{"fullCount":0}}}`)
+
+		require.Equal(t, int64(0), cursor.Count())
+		require.Equal(t, int64(0), cursor.FullCount())
+	})
+}
+
+func Benchmark_cursor_decodeDocument(b *testing.B) {
+	const batchSize = 1000
+
+	batch := []byte(`[`)
+	for i := 0; i < batchSize; i++ {
+		if i > 0 {
+			batch = append(batch, ',')
+		}
+		batch = append(batch, fmt.Sprintf(`{"_key":"%d","_id":"col/%d","_rev":"_rev%d","name":"user-%d","age":%d,"tags":["a","b","c"]}`, i, i, i, i, i)...)
+	}
+	batch = append(batch, ']')
+
+	type document struct {
+		Name string   `json:"name"`
+		Age  int      `json:"age"`
+		Tags []string `json:"tags"`
+	}
+
+	run := func(b *testing.B, buffer func(c *cursor) *byteDecoder) {
+		c := &cursor{}
+		var doc document
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if i%batchSize == 0 {
+				b.StopTimer()
+				require.NoError(b, c.data.Result.UnmarshalJSON(batch))
+				b.StartTimer()
+			}
+
+			if _, err := c.decodeDocument(buffer(c), &doc); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("reused buffer", func(b *testing.B) {
+		run(b, func(c *cursor) *byteDecoder {
+			return &c.buffer
+		})
+	})
+
+	// The buffer is allocated for every document, as it was done before the cursor kept its own buffer.
+	b.Run("new buffer", func(b *testing.B) {
+		run(b, func(c *cursor) *byteDecoder {
+			return &byteDecoder{}
+		})
+	})
+}
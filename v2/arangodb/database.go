@@ -31,6 +31,13 @@ type Database interface {
 	// Info fetches information about the database.
 	Info(ctx context.Context) (DatabaseInfo, error)
 
+	// EngineInfo returns information about the storage engine of the server.
+	EngineInfo(ctx context.Context) (EngineInfo, error)
+
+	// Version returns the version of the server which serves the database.
+	// Use Version.SupportsFeature to check whether the server supports a given feature.
+	Version(ctx context.Context) (VersionInfo, error)
+
 	// Remove removes the entire database.
 	// If the database does not exist, a NotFoundError is returned.
 	Remove(ctx context.Context) error
@@ -38,10 +45,16 @@ type Database interface {
 	// TransactionJS performs a javascript transaction. The result of the transaction function is returned.
 	TransactionJS(ctx context.Context, options TransactionJSOptions) (interface{}, error)
 
+	// SetCollectionAccess sets the access the given user has to a collection of the database.
+	// It is a shortcut for User.SetCollectionAccess, which does not require to fetch the user first.
+	// Use "*" as the collection name to set the default access to all the collections of the database.
+	SetCollectionAccess(ctx context.Context, user, collection string, access Grant) error
+
 	DatabaseCollection
 	DatabaseTransaction
 	DatabaseQuery
 	DatabaseView
 	DatabaseAnalyzer
 	DatabaseGraph
+	DatabaseSchema
 }
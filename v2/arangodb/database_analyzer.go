@@ -34,6 +34,14 @@ type DatabaseAnalyzer interface {
 
 	// Analyzers return an iterator to read all analyzers
 	Analyzers(ctx context.Context) (AnalyzersResponseReader, error)
+
+	// AnalyzersAll returns all analyzers, including the built-in ones.
+	AnalyzersAll(ctx context.Context) ([]Analyzer, error)
+
+	// DeleteAnalyzer removes the analyzer with the given name.
+	// When force is set to true, the analyzer is removed even if it is in use, e.g. by a view.
+	// If the analyzer does not exist, a NotFoundError is returned.
+	DeleteAnalyzer(ctx context.Context, name string, force bool) error
 }
 
 type AnalyzersResponseReader interface {
@@ -106,6 +106,35 @@ func (d databaseAnalyzer) Analyzers(ctx context.Context) (AnalyzersResponseReade
 	}
 }
 
+func (d databaseAnalyzer) AnalyzersAll(ctx context.Context) ([]Analyzer, error) {
+	urlEndpoint := d.db.url("_api", "analyzer")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Analyzers             []AnalyzerDefinition `json:"result,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		result := make([]Analyzer, len(response.Analyzers))
+		for i, def := range response.Analyzers {
+			result[i] = newAnalyzer(d.db, def)
+		}
+		return result, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseAnalyzer) DeleteAnalyzer(ctx context.Context, name string, force bool) error {
+	return newAnalyzer(d.db, AnalyzerDefinition{Name: name}).Remove(ctx, force)
+}
+
 func newAnalyzersResponseReader(db *database, arr *connection.Array) AnalyzersResponseReader {
 	return &analyzerResponseReader{
 		array: arr,
@@ -47,6 +47,11 @@ type DatabaseCollection interface {
 	// CreateCollectionWithOptions creates a new collection with given name and options, and opens a connection to it.
 	// If a collection with given name already exists within the database, a DuplicateError is returned.
 	CreateCollectionWithOptions(ctx context.Context, name string, props *CreateCollectionProperties, options *CreateCollectionOptions) (Collection, error)
+
+	// OrphanedCollections returns the names of the non-system collections which are not referenced
+	// by any graph (edge definitions and orphan collections) nor by any ArangoSearch or search-alias view.
+	// The result is sorted by name.
+	OrphanedCollections(ctx context.Context) ([]string, error)
 }
 
 type GetCollectionOptions struct {
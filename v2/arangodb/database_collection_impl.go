@@ -24,6 +24,8 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -138,8 +140,154 @@ func (d databaseCollection) CreateCollectionWithOptions(ctx context.Context, nam
 
 	switch code := resp.Code(); code {
 	case http.StatusOK:
+		if options != nil && options.WaitForReady {
+			if err := d.waitForCollection(ctx, name, options.WaitForReadyTimeout); err != nil {
+				return nil, err
+			}
+		}
 		return newCollection(d.db, name), nil
 	default:
 		return nil, respData.AsArangoErrorWithCode(code)
 	}
 }
+
+// waitForCollection waits until the collection is visible on all the endpoints of the connection.
+func (d databaseCollection) waitForCollection(ctx context.Context, name string, timeout time.Duration) error {
+	endpoints := d.db.connection().GetEndpoint().List()
+	if len(endpoints) < 2 {
+		// The collection has been created on the only endpoint.
+		return nil
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		var missing []string
+		for _, endpoint := range endpoints {
+			exists, err := d.CollectionExists(connection.WithEndpoint(ctx, endpoint), name)
+			if err != nil && ctx.Err() == nil {
+				return errors.WithStack(err)
+			}
+			if !exists {
+				missing = append(missing, endpoint)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("collection '%s' is not visible on the endpoints %v: %s", name, missing, ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (d databaseCollection) OrphanedCollections(ctx context.Context) ([]string, error) {
+	urlEndpoint := d.db.url("_api", "collection")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                []CollectionInfo `json:"result,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response, connection.WithQuery("excludeSystem", "true"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// Fallthrough.
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+
+	referenced, err := d.referencedCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(response.Result))
+	for _, info := range response.Result {
+		if info.IsSystem || referenced[info.Name] {
+			continue
+		}
+		result = append(result, info.Name)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// referencedCollections returns the names of the collections which are used by graphs and views.
+func (d databaseCollection) referencedCollections(ctx context.Context) (map[string]bool, error) {
+	referenced := map[string]bool{}
+
+	graphs, err := d.db.Graphs(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for {
+		graph, err := graphs.Read()
+		if shared.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, edge := range graph.EdgeDefinitions() {
+			referenced[edge.Collection] = true
+			for _, name := range edge.From {
+				referenced[name] = true
+			}
+			for _, name := range edge.To {
+				referenced[name] = true
+			}
+		}
+		for _, name := range graph.OrphanCollections() {
+			referenced[name] = true
+		}
+	}
+
+	views, err := d.db.ViewsAll(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, view := range views {
+		switch view.Type() {
+		case ViewTypeArangoSearch:
+			searchView, err := view.ArangoSearchView()
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			props, err := searchView.Properties(ctx)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			for name := range props.Links {
+				referenced[name] = true
+			}
+		case ViewTypeSearchAlias:
+			aliasView, err := view.ArangoSearchViewAlias()
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			props, err := aliasView.Properties(ctx)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			for _, index := range props.Indexes {
+				referenced[index.Collection] = true
+			}
+		}
+	}
+
+	return referenced, nil
+}
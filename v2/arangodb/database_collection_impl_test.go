@@ -0,0 +1,109 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// newLaggingCoordinator starts a server which creates collections,
+// but reports them as not found for the given number of the first lookups.
+func newLaggingCoordinator(t *testing.T, lookupsNotFound int) *httptest.Server {
+	var lock sync.Mutex
+	var lookups int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/_db/db/_api/collection":
+			w.Write([]byte(`{"error":false,"code":200}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/collection/users":
+			lock.Lock()
+			lookups++
+			notFound := lookups <= lookupsNotFound
+			lock.Unlock()
+
+			if notFound {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":true,"code":404,"errorNum":1203,"errorMessage":"collection or view not found"}`))
+				return
+			}
+			w.Write([]byte(`{"error":false,"code":200,"name":"users"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_databaseCollection_CreateCollectionWaitForReady(t *testing.T) {
+	newDB := func(servers ...*httptest.Server) Database {
+		endpoints := make([]string, len(servers))
+		for i, s := range servers {
+			endpoints[i] = s.URL
+		}
+		conn := connection.NewHttpConnection(connection.HttpConfiguration{
+			Endpoint: connection.NewRoundRobinEndpoints(endpoints),
+		})
+		return newDatabase(newClient(conn), "db")
+	}
+
+	t.Run("waits until the collection is visible everywhere", func(t *testing.T) {
+		db := newDB(newLaggingCoordinator(t, 0), newLaggingCoordinator(t, 3))
+
+		col, err := db.CreateCollectionWithOptions(context.Background(), "users", nil, &CreateCollectionOptions{
+			WaitForReady: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "users", col.Name())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		lagging := newLaggingCoordinator(t, 1000)
+		db := newDB(newLaggingCoordinator(t, 0), lagging)
+
+		_, err := db.CreateCollectionWithOptions(context.Background(), "users", nil, &CreateCollectionOptions{
+			WaitForReady:        true,
+			WaitForReadyTimeout: 300 * time.Millisecond,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), lagging.URL)
+	})
+
+	t.Run("does not wait by default", func(t *testing.T) {
+		db := newDB(newLaggingCoordinator(t, 0), newLaggingCoordinator(t, 1000))
+
+		_, err := db.CreateCollectionWithOptions(context.Background(), "users", nil, nil)
+		require.NoError(t, err)
+	})
+}
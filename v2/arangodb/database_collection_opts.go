@@ -21,6 +21,8 @@
 package arangodb
 
 import (
+	"time"
+
 	"github.com/arangodb/go-driver/v2/connection"
 )
 
@@ -122,6 +124,15 @@ type CreateCollectionOptions struct {
 	// EnforceReplicationFactor the default is true, which means the server checks if there are enough replicas available
 	// at creation time and bail out otherwise. Set it to false to disable this extra check.
 	EnforceReplicationFactor *bool
+
+	// WaitForReady waits after the creation until the collection is visible on all the endpoints of the connection,
+	// e.g. on all the coordinators of a cluster. Otherwise, a request sent to another coordinator right after
+	// the creation may fail with a not found error.
+	WaitForReady bool
+
+	// WaitForReadyTimeout limits the time of waiting for the collection to be visible on all the endpoints.
+	// Default: the time is limited by the context only.
+	WaitForReadyTimeout time.Duration
 }
 
 func (o *CreateCollectionOptions) modifyRequest(r connection.Request) error {
@@ -40,6 +40,7 @@ func newDatabase(c *client, name string, modifiers ...connection.RequestModifier
 	d.databaseView = newDatabaseView(d)
 	d.databaseAnalyzer = newDatabaseAnalyzer(d)
 	d.databaseGraph = newDatabaseGraph(d)
+	d.databaseSchema = newDatabaseSchema(d)
 
 	return d
 }
@@ -57,6 +58,7 @@ type database struct {
 	*databaseView
 	*databaseAnalyzer
 	*databaseGraph
+	*databaseSchema
 }
 
 func (d database) Remove(ctx context.Context) error {
@@ -108,6 +110,52 @@ func (d database) Info(ctx context.Context) (DatabaseInfo, error) {
 	}
 }
 
+func (d database) SetCollectionAccess(ctx context.Context, user, collection string, access Grant) error {
+	return newUser(d.client, &userResponse{Name: user}).SetCollectionAccess(ctx, d.name, collection, access)
+}
+
+func (d database) Version(ctx context.Context) (VersionInfo, error) {
+	urlEndpoint := d.url("_api", "version")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		VersionInfo
+	}
+
+	resp, err := connection.CallGet(ctx, d.client.connection, urlEndpoint, &response)
+	if err != nil {
+		return VersionInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.VersionInfo, nil
+	default:
+		return VersionInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d database) EngineInfo(ctx context.Context) (EngineInfo, error) {
+	urlEndpoint := d.url("_api", "engine")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		EngineInfo
+	}
+
+	resp, err := connection.CallGet(ctx, d.client.connection, urlEndpoint, &response)
+	if err != nil {
+		return EngineInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.EngineInfo, nil
+	default:
+		return EngineInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (d database) TransactionJS(ctx context.Context, options TransactionJSOptions) (interface{}, error) {
 	urlEndpoint := d.url("_api", "transaction")
 
@@ -20,6 +20,12 @@
 
 package arangodb
 
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
 // DatabaseInfo contains information about a database
 type DatabaseInfo struct {
 	// The identifier of the database.
@@ -62,4 +68,25 @@ func (t EngineType) String() string {
 // EngineInfo contains information about the database engine being used.
 type EngineInfo struct {
 	Type EngineType `json:"name"`
+	// Supports describes the features supported by the engine, e.g. the index types.
+	Supports map[string]interface{} `json:"supports,omitempty"`
+}
+
+// EngineNotSupportedError is returned when the operation is not supported by the storage engine of the server.
+type EngineNotSupportedError struct {
+	// Engine is the storage engine of the server.
+	Engine EngineType
+	// Operation is the name of the unsupported operation.
+	Operation string
+}
+
+// Error implements the error interface.
+func (e EngineNotSupportedError) Error() string {
+	return fmt.Sprintf("operation '%s' is unsupported on the '%s' engine", e.Operation, e.Engine)
+}
+
+// IsEngineNotSupported returns true if the given error is an EngineNotSupportedError.
+func IsEngineNotSupported(err error) bool {
+	var e EngineNotSupportedError
+	return errors.As(err, &e)
 }
@@ -22,6 +22,7 @@ package arangodb
 
 import (
 	"context"
+	"time"
 
 	"github.com/arangodb/go-driver/v2/connection"
 )
@@ -41,8 +42,175 @@ type DatabaseQuery interface {
 	// The query is not executed.
 	ValidateQuery(ctx context.Context, query string) error
 
+	// ParseQuery parses an AQL query and returns information about it.
+	// The query is not executed.
+	// A syntax error is returned as an ArangoError, use shared.IsQueryParseError to detect it
+	// and shared.GetQueryErrorLocation to find out where the error is located in the query.
+	ParseQuery(ctx context.Context, query string) (ParseQueryResult, error)
+
 	// ExplainQuery explains an AQL query and return information about it.
 	ExplainQuery(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (ExplainQueryResult, error)
+
+	// CompareQueryPlans explains an AQL query with all the possible execution plans and returns them
+	// sorted by the estimated cost, the cheapest plan first.
+	// It is a tuning aid, the returned comparison lists the optimizer rules which differentiate the plans.
+	// The options are passed to ExplainQuery with AllPlans enabled, e.g. MaxNumberOfPlans limits the number of compared plans.
+	CompareQueryPlans(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (QueryPlanComparison, error)
+
+	// QueryCacheProperties returns the global properties of the AQL query results cache.
+	QueryCacheProperties(ctx context.Context) (QueryCacheProperties, error)
+
+	// SetQueryCacheProperties changes the global properties of the AQL query results cache.
+	// Only the fields which are set are changed. The new properties are returned.
+	SetQueryCacheProperties(ctx context.Context, props QueryCacheProperties) (QueryCacheProperties, error)
+
+	// ClearQueryCache clears the AQL query results cache of the database.
+	ClearQueryCache(ctx context.Context) error
+
+	// QueryCacheEntries returns the entries of the AQL query results cache of the database.
+	QueryCacheEntries(ctx context.Context) ([]QueryCacheEntry, error)
+
+	// RunningQueries returns the AQL queries which are currently running in the database.
+	RunningQueries(ctx context.Context) ([]QueryEntry, error)
+
+	// SlowQueries returns the last AQL queries in the database which exceeded the slow query threshold.
+	SlowQueries(ctx context.Context) ([]QueryEntry, error)
+
+	// KillQuery kills the running AQL query with the given ID.
+	// If the query is not running, a NotFoundError is returned.
+	KillQuery(ctx context.Context, id string) error
+
+	// QueryTrackingProperties returns the properties of the AQL query tracking.
+	QueryTrackingProperties(ctx context.Context) (QueryTrackingProperties, error)
+
+	// SetQueryTrackingProperties changes the properties of the AQL query tracking, e.g. the slow query threshold.
+	// Only the fields which are set are changed. The new properties are returned.
+	SetQueryTrackingProperties(ctx context.Context, props QueryTrackingProperties) (QueryTrackingProperties, error)
+
+	// BatchQuery performs multiple independent AQL queries in a single batch request.
+	// The responses are returned in the order of the requests. A failure of a single query is
+	// reported in its response, the returned error is set only if the whole batch request fails.
+	// Note that every returned Cursor must always be closed.
+	BatchQuery(ctx context.Context, requests []BatchQueryRequest) ([]BatchQueryResponse, error)
+
+	// OptimizerRules returns the optimizer rules available for AQL queries.
+	// The rules can be enabled or disabled for a single query with QueryOptions.Options.Optimizer.Rules,
+	// e.g. "-use-indexes" disables the rule "use-indexes".
+	OptimizerRules(ctx context.Context) ([]OptimizerRule, error)
+}
+
+// OptimizerRule describes an optimizer rule for AQL queries.
+type OptimizerRule struct {
+	// Name is the name of the optimizer rule.
+	Name string `json:"name"`
+	// Flags describe the behavior of the optimizer rule.
+	Flags OptimizerRuleFlags `json:"flags"`
+}
+
+// OptimizerRuleFlags describes the behavior of an optimizer rule.
+type OptimizerRuleFlags struct {
+	// Hidden is true if the rule is not shown to the users in the explain output.
+	Hidden bool `json:"hidden"`
+	// ClusterOnly is true if the rule is applied in cluster deployments only.
+	ClusterOnly bool `json:"clusterOnly"`
+	// CanBeDisabled is true if the rule can be disabled by the users.
+	CanBeDisabled bool `json:"canBeDisabled"`
+	// CanCreateAdditionalPlans is true if the rule may create additional execution plans.
+	CanCreateAdditionalPlans bool `json:"canCreateAdditionalPlans"`
+	// DisabledByDefault is true if the rule is not applied unless it is explicitly enabled.
+	DisabledByDefault bool `json:"disabledByDefault"`
+	// EnterpriseOnly is true if the rule is available in the Enterprise Edition only.
+	EnterpriseOnly bool `json:"enterpriseOnly"`
+}
+
+// QueryEntry describes a running or slow AQL query.
+type QueryEntry struct {
+	// ID is the identifier of the query.
+	ID string `json:"id"`
+	// Database is the name of the database the query runs in.
+	Database string `json:"database,omitempty"`
+	// User is the name of the user who started the query.
+	User string `json:"user,omitempty"`
+	// Query is the query string, possibly truncated.
+	Query string `json:"query"`
+	// BindVars contains the bind parameters of the query, if they are tracked.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// Started is the date and time when the query was started.
+	Started time.Time `json:"started"`
+	// RunTime is the query's run time up to the moment the list was retrieved, in seconds.
+	RunTime float64 `json:"runTime"`
+	// PeakMemoryUsage is the query's peak memory usage in bytes.
+	PeakMemoryUsage uint64 `json:"peakMemoryUsage,omitempty"`
+	// State is the query's current execution state, e.g. "executing" or "finished" for slow queries.
+	State string `json:"state"`
+	// Stream is true if the query is a streaming query.
+	Stream bool `json:"stream,omitempty"`
+}
+
+// QueryTrackingProperties contains the properties of the AQL query tracking.
+type QueryTrackingProperties struct {
+	// Enabled enables the tracking of the queries.
+	Enabled *bool `json:"enabled,omitempty"`
+	// TrackSlowQueries enables the tracking of the slow queries.
+	TrackSlowQueries *bool `json:"trackSlowQueries,omitempty"`
+	// TrackBindVars enables the tracking of the bind parameters of the queries.
+	TrackBindVars *bool `json:"trackBindVars,omitempty"`
+	// MaxSlowQueries is the maximum number of slow queries to keep in the list.
+	MaxSlowQueries *int `json:"maxSlowQueries,omitempty"`
+	// SlowQueryThreshold is the run time in seconds after which a query is treated as slow.
+	SlowQueryThreshold *float64 `json:"slowQueryThreshold,omitempty"`
+	// SlowStreamingQueryThreshold is the run time in seconds after which a streaming query is treated as slow.
+	SlowStreamingQueryThreshold *float64 `json:"slowStreamingQueryThreshold,omitempty"`
+	// MaxQueryStringLength is the maximum query string length in bytes to keep in the query lists.
+	MaxQueryStringLength *int `json:"maxQueryStringLength,omitempty"`
+}
+
+// QueryCacheMode is the mode of the AQL query results cache.
+type QueryCacheMode string
+
+const (
+	// QueryCacheModeOff disables the query results cache.
+	QueryCacheModeOff QueryCacheMode = "off"
+	// QueryCacheModeOn caches the results of all cacheable queries, unless they have the `cache` option set to false.
+	QueryCacheModeOn QueryCacheMode = "on"
+	// QueryCacheModeDemand caches only the results of the queries which have the `cache` option set to true.
+	QueryCacheModeDemand QueryCacheMode = "demand"
+)
+
+// QueryCacheProperties contains the global properties of the AQL query results cache.
+type QueryCacheProperties struct {
+	// Mode is the mode the AQL query cache operates in.
+	Mode QueryCacheMode `json:"mode,omitempty"`
+	// MaxResults is the maximum number of query results that are stored per database-specific cache.
+	MaxResults *uint64 `json:"maxResults,omitempty"`
+	// MaxResultsSize is the maximum cumulated size of query results that are stored per database-specific cache (in bytes).
+	MaxResultsSize *uint64 `json:"maxResultsSize,omitempty"`
+	// MaxEntrySize is the maximum individual result size of queries that are stored per database-specific cache (in bytes).
+	MaxEntrySize *uint64 `json:"maxEntrySize,omitempty"`
+	// IncludeSystem indicates whether results of queries that involve system collections are stored in the cache.
+	IncludeSystem *bool `json:"includeSystem,omitempty"`
+}
+
+// QueryCacheEntry describes a query result stored in the AQL query results cache.
+type QueryCacheEntry struct {
+	// Hash is the hash value calculated from the query string and the bind parameters.
+	Hash string `json:"hash"`
+	// Query is the query string.
+	Query string `json:"query"`
+	// BindVars contains the bind parameters of the query, if they are tracked.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// Size is the size of the query result and the bind parameters in bytes.
+	Size uint64 `json:"size"`
+	// Results is the number of documents or rows in the query result.
+	Results uint64 `json:"results"`
+	// Hits is the number of times the result was served from the cache.
+	Hits uint64 `json:"hits"`
+	// RunTime is the query's run time in seconds.
+	RunTime float64 `json:"runTime"`
+	// Started is the date and time when the query was stored in the cache.
+	Started time.Time `json:"started"`
+	// DataSources contains the names of the collections and views involved in the query.
+	DataSources []string `json:"dataSources,omitempty"`
 }
 
 type QuerySubOptions struct {
@@ -57,6 +225,7 @@ type QuerySubOptions struct {
 
 	// AllowRetry If set to `true`, ArangoDB will store cursor results in such a way
 	// that batch reads can be retried in the case of a communication error.
+	// The cursor fetches the next batch again automatically when its response is lost.
 	AllowRetry bool `json:"allowRetry,omitempty"`
 
 	// When set to true, the query will throw an exception and abort instead of producing a warning.
@@ -207,8 +376,8 @@ type QueryOptions struct {
 
 	// flag to determine whether the AQL query cache shall be used. If set to false, then any query cache lookup
 	// will be skipped for the query. If set to true, it will lead to the query cache being checked for the query
-	// if the query cache mode is either on or demand.
-	Cache bool `json:"cache,omitempty"`
+	// if the query cache mode is either on or demand. If not set, the server default is used.
+	Cache *bool `json:"cache,omitempty"`
 
 	// the maximum number of memory (measured in bytes) that the query is allowed to use. If set, then the query will fail
 	// with error "resource limit exceeded" in case it allocates too much memory. A value of 0 indicates that there is no memory limit.
@@ -222,6 +391,11 @@ type QueryOptions struct {
 	// key/value pairs representing the bind parameters.
 	BindVars map[string]interface{} `json:"bindVars,omitempty"`
 	Options  QuerySubOptions        `json:"options,omitempty"`
+
+	// ValidateBindVars enables the client side check that all the bind parameters used in the query are provided
+	// in BindVars, and that all the provided bind parameters are used in the query.
+	// An InvalidArgumentError is returned without sending the query to the server when the check fails.
+	ValidateBindVars bool `json:"-"`
 }
 
 func (q *QueryOptions) modifyRequest(r connection.Request) error {
@@ -253,6 +427,22 @@ type QueryRequest struct {
 	Query string `json:"query"`
 }
 
+// BatchQueryRequest is a single query sent with DatabaseQuery.BatchQuery.
+type BatchQueryRequest struct {
+	// Query is the AQL query to run.
+	Query string `json:"query"`
+	// BindVars contains the bind parameters of the query.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+}
+
+// BatchQueryResponse is the result of a single query sent with DatabaseQuery.BatchQuery.
+type BatchQueryResponse struct {
+	// Cursor is set if the query succeeded.
+	Cursor Cursor
+	// Err is set if the query failed.
+	Err error
+}
+
 type ExplainQueryOptimizerOptions struct {
 	// A list of to-be-included or to-be-excluded optimizer rules can be put into this attribute,
 	// telling the optimizer to include or exclude specific rules.
@@ -308,6 +498,49 @@ type ExplainQueryResultPlan struct {
 	EstimatedNrItems int `json:"estimatedNrItems,omitempty"`
 }
 
+// QueryPlanComparison is the result of comparing all the execution plans of an AQL query.
+type QueryPlanComparison struct {
+	// Plans are the execution plans sorted by the estimated cost, the cheapest plan first.
+	Plans []ExplainQueryResultPlan `json:"plans,omitempty"`
+
+	// DifferentiatingRules are the optimizer rules which are applied to some of the plans, but not to all of them.
+	// The rules are sorted by name.
+	DifferentiatingRules []string `json:"differentiatingRules,omitempty"`
+
+	// Warnings that occurred during optimization or execution plan creation.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Cheapest returns the plan with the lowest estimated cost.
+// False is returned when there are no plans.
+func (q QueryPlanComparison) Cheapest() (ExplainQueryResultPlan, bool) {
+	if len(q.Plans) == 0 {
+		return ExplainQueryResultPlan{}, false
+	}
+
+	return q.Plans[0], true
+}
+
+// DifferentiatingRulesOf returns the differentiating optimizer rules which are applied to the plan with the given index.
+// The rules may be applied to other plans too, but not to all of them.
+func (q QueryPlanComparison) DifferentiatingRulesOf(index int) []string {
+	if index < 0 || index >= len(q.Plans) {
+		return nil
+	}
+
+	var rules []string
+	for _, rule := range q.DifferentiatingRules {
+		for _, r := range q.Plans[index].Rules {
+			if r == rule {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+
+	return rules
+}
+
 type ExplainQueryResultExecutionStats struct {
 	RulesExecuted   int     `json:"rulesExecuted,omitempty"`
 	RulesSkipped    int     `json:"rulesSkipped,omitempty"`
@@ -316,6 +549,39 @@ type ExplainQueryResultExecutionStats struct {
 	ExecutionTime   float64 `json:"executionTime,omitempty"`
 }
 
+type ParseQueryResult struct {
+	// Parsed is true when the query has been parsed successfully.
+	Parsed bool `json:"parsed,omitempty"`
+
+	// Collections is the list of collections used in the query.
+	Collections []string `json:"collections,omitempty"`
+
+	// BindVars is the list of bind parameters used in the query.
+	// Collection bind parameters are listed with the leading `@`.
+	BindVars []string `json:"bindVars,omitempty"`
+
+	// AST is the abstract syntax tree of the query.
+	AST []QueryASTNode `json:"ast,omitempty"`
+}
+
+// QueryASTNode is a single node of the abstract syntax tree of an AQL query.
+type QueryASTNode struct {
+	// Type of the node, e.g. "root", "for", "collection", "return".
+	Type string `json:"type,omitempty"`
+
+	// Name of the node, e.g. the name of a variable, collection or function.
+	Name string `json:"name,omitempty"`
+
+	// ID of the node, e.g. of a variable.
+	ID *int `json:"id,omitempty"`
+
+	// Value of the node, it is set for value nodes only.
+	Value interface{} `json:"value,omitempty"`
+
+	// SubNodes are the child nodes.
+	SubNodes []QueryASTNode `json:"subNodes,omitempty"`
+}
+
 type ExplainQueryResult struct {
 	Plan  ExplainQueryResultPlan   `json:"plan,omitempty"`
 	Plans []ExplainQueryResultPlan `json:"plans,omitempty"`
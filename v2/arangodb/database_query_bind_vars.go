@@ -0,0 +1,116 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// validateBindVars checks that every bind parameter used in the query is provided in bindVars,
+// and that every provided bind parameter is used in the query.
+// The collection bind parameters (`@@name`) are expected under the `@name` key.
+func validateBindVars(query string, bindVars map[string]interface{}) error {
+	used := queryBindVars(query)
+
+	var missing, unused []string
+	for name := range used {
+		if _, ok := bindVars[name]; !ok {
+			missing = append(missing, "@"+name)
+		}
+	}
+	for name := range bindVars {
+		if _, ok := used[name]; !ok {
+			unused = append(unused, "@"+name)
+		}
+	}
+
+	if len(missing) == 0 && len(unused) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unused)
+
+	var messages []string
+	if len(missing) > 0 {
+		messages = append(messages, fmt.Sprintf("missing bind parameters: %s", strings.Join(missing, ", ")))
+	}
+	if len(unused) > 0 {
+		messages = append(messages, fmt.Sprintf("unused bind parameters: %s", strings.Join(unused, ", ")))
+	}
+
+	return errors.WithStack(shared.InvalidArgumentError{Message: strings.Join(messages, "; ")})
+}
+
+// queryBindVars returns the names of the bind parameters used in the query.
+// String literals, quoted names and comments are skipped.
+func queryBindVars(query string) map[string]struct{} {
+	names := map[string]struct{}{}
+
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '"' || c == '\'' || c == '`':
+			// Skip the string literal or the quoted name.
+			for i++; i < len(query) && query[i] != c; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case strings.HasPrefix(query[i:], "//"):
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return names
+			}
+			i += end + 3
+		case c == '@':
+			start := i + 1
+			if start < len(query) && query[start] == '@' {
+				// The collection bind parameter keeps one `@` in its name.
+				i++
+			}
+
+			end := i + 1
+			for end < len(query) && isBindVarNameChar(query[end]) {
+				end++
+			}
+			if end > i+1 {
+				names[query[start:end]] = struct{}{}
+			}
+			i = end - 1
+		}
+	}
+
+	return names
+}
+
+func isBindVarNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_queryBindVars(t *testing.T) {
+	testCases := map[string]struct {
+		query    string
+		expected []string
+	}{
+		"no bind parameters": {
+			query: "FOR d IN users RETURN d",
+		},
+		"value and collection": {
+			query:    "FOR d IN @@col FILTER d.age > @age && d.name == @name RETURN d",
+			expected: []string{"@col", "age", "name"},
+		},
+		"repeated": {
+			query:    "FOR d IN @@col FILTER d.a == @v || d.b == @v RETURN d",
+			expected: []string{"@col", "v"},
+		},
+		"strings and comments are skipped": {
+			query: "RETURN CONCAT('@a', \"@b\", 'it\\'s @c') // @d\n /* @e */ RETURN `@f`",
+		},
+		"after comment": {
+			query:    "/* @skipped */ RETURN @used",
+			expected: []string{"used"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			names := queryBindVars(testCase.query)
+
+			actual := make([]string, 0, len(names))
+			for n := range names {
+				actual = append(actual, n)
+			}
+			require.ElementsMatch(t, testCase.expected, actual)
+		})
+	}
+}
+
+func Test_validateBindVars(t *testing.T) {
+	query := "FOR d IN @@col FILTER d.age > @age RETURN d"
+
+	t.Run("all bind parameters provided", func(t *testing.T) {
+		require.NoError(t, validateBindVars(query, map[string]interface{}{"@col": "users", "age": 3}))
+	})
+
+	t.Run("missing bind parameter", func(t *testing.T) {
+		err := validateBindVars(query, map[string]interface{}{"@col": "users"})
+		require.True(t, shared.IsInvalidArgument(err))
+		require.EqualError(t, err, "missing bind parameters: @age")
+	})
+
+	t.Run("missing collection bind parameter", func(t *testing.T) {
+		err := validateBindVars(query, map[string]interface{}{"col": "users", "age": 3})
+		require.True(t, shared.IsInvalidArgument(err))
+		require.EqualError(t, err, "missing bind parameters: @@col; unused bind parameters: @col")
+	})
+
+	t.Run("extra bind parameter", func(t *testing.T) {
+		err := validateBindVars(query, map[string]interface{}{"@col": "users", "age": 3, "name": "john"})
+		require.True(t, shared.IsInvalidArgument(err))
+		require.EqualError(t, err, "unused bind parameters: @name")
+	})
+}
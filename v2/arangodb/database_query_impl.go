@@ -21,9 +21,20 @@
 package arangodb
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
 
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
 
@@ -47,6 +58,12 @@ func (d databaseQuery) Query(ctx context.Context, query string, opts *QueryOptio
 }
 
 func (d databaseQuery) getCursor(ctx context.Context, query string, opts *QueryOptions, result interface{}) (*cursor, error) {
+	if opts != nil && opts.ValidateBindVars {
+		if err := validateBindVars(query, opts.BindVars); err != nil {
+			return nil, err
+		}
+	}
+
 	url := d.db.url("_api", "cursor")
 
 	req := struct {
@@ -62,15 +79,17 @@ func (d databaseQuery) getCursor(ctx context.Context, query string, opts *QueryO
 		cursorData            `json:",inline"`
 	}
 
-	resp, err := connection.CallPost(ctx, d.db.connection(), url, &response, &req, append(d.db.modifiers, opts.modifyRequest)...)
+	resp, err := connection.CallPost(ctx, d.db.connection(), url, &response, &req, append(d.db.modifiers, withDirtyRead(ctx), opts.modifyRequest)...)
 	if err != nil {
 		return nil, err
 	}
 
 	switch code := resp.Code(); code {
 	case http.StatusCreated:
+		readDirtyReadResponse(ctx, resp)
+		connection.BindDecoder(d.db.connection().Decoder(resp.Content()), &response.cursorData)
 		if result != nil {
-			if err := json.Unmarshal(response.cursorData.Result.in, result); err != nil {
+			if err := response.cursorData.Result.unmarshal(result); err != nil {
 				return nil, err
 			}
 		}
@@ -80,6 +99,122 @@ func (d databaseQuery) getCursor(ctx context.Context, query string, opts *QueryO
 	}
 }
 
+// batchPartContentType is the content type of a single request or response of the batch request.
+const batchPartContentType = "application/x-arango-batchpart"
+
+func (d databaseQuery) BatchQuery(ctx context.Context, requests []BatchQueryRequest) ([]BatchQueryResponse, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for i, request := range requests {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			connection.ContentType: {batchPartContentType},
+			"Content-Id":           {strconv.Itoa(i)},
+		})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if _, err := fmt.Fprintf(part, "POST /_api/cursor HTTP/1.1\r\nContent-Length: %d\r\n\r\n%s", len(data), data); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	url := d.db.url("_api", "batch")
+
+	// The body is passed as an io.Seeker, so it is sent again from the start when the request is retried.
+	mods := append([]connection.RequestModifier{
+		connection.WithBody(bytes.NewReader(body.Bytes())),
+		withMultipartContent(writer.Boundary()),
+	}, d.db.modifiers...)
+
+	resp, respBody, err := connection.CallStream(ctx, d.db.connection(), http.MethodPost, url, mods...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer respBody.Close()
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// Fallthrough.
+	default:
+		var response shared.ResponseStruct
+		_ = json.NewDecoder(respBody).Decode(&response)
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+
+	boundary := writer.Boundary()
+	if _, params, err := mime.ParseMediaType(resp.Header(connection.ContentType)); err == nil && params["boundary"] != "" {
+		boundary = params["boundary"]
+	}
+
+	responses := make([]BatchQueryResponse, 0, len(requests))
+	reader := multipart.NewReader(respBody, boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		responses = append(responses, d.readBatchQueryResponse(part, resp.Endpoint()))
+	}
+
+	if len(responses) != len(requests) {
+		return nil, errors.Errorf("expected %d batch responses, got %d", len(requests), len(responses))
+	}
+
+	return responses, nil
+}
+
+// readBatchQueryResponse decodes a single cursor response of the batch request.
+func (d databaseQuery) readBatchQueryResponse(part io.Reader, endpoint string) BatchQueryResponse {
+	resp, err := http.ReadResponse(bufio.NewReader(part), nil)
+	if err != nil {
+		return BatchQueryResponse{Err: errors.WithStack(err)}
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		cursorData            `json:",inline"`
+	}
+
+	decoder := d.db.connection().Decoder(connection.ApplicationJSON)
+	if err := decoder.Decode(resp.Body, &response); err != nil && err != io.EOF {
+		return BatchQueryResponse{Err: errors.WithStack(err)}
+	}
+	connection.BindDecoder(decoder, &response.cursorData)
+
+	switch code := resp.StatusCode; code {
+	case http.StatusCreated:
+		return BatchQueryResponse{Cursor: newCursor(d.db, endpoint, response.cursorData)}
+	default:
+		return BatchQueryResponse{Err: response.AsArangoErrorWithCode(code)}
+	}
+}
+
+// withMultipartContent sets the content type of the request body to the multipart batch request.
+func withMultipartContent(boundary string) connection.RequestModifier {
+	return func(r connection.Request) error {
+		r.AddHeader(connection.ContentType, "multipart/form-data; boundary="+boundary)
+		return nil
+	}
+}
+
 func (d databaseQuery) QueryBatch(ctx context.Context, query string, opts *QueryOptions, result interface{}) (CursorBatch, error) {
 	return d.getCursor(ctx, query, opts, result)
 }
@@ -106,6 +241,29 @@ func (d databaseQuery) ValidateQuery(ctx context.Context, query string) error {
 	}
 }
 
+func (d databaseQuery) ParseQuery(ctx context.Context, query string) (ParseQueryResult, error) {
+	url := d.db.url("_api", "query")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ParseQueryResult      `json:",inline"`
+	}
+
+	queryStruct := QueryRequest{Query: query}
+
+	resp, err := connection.CallPost(ctx, d.db.connection(), url, &response, &queryStruct, d.db.modifiers...)
+	if err != nil {
+		return ParseQueryResult{}, err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ParseQueryResult, nil
+	default:
+		return ParseQueryResult{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (d databaseQuery) ExplainQuery(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (ExplainQueryResult, error) {
 	url := d.db.url("_api", "explain")
 
@@ -134,3 +292,222 @@ func (d databaseQuery) ExplainQuery(ctx context.Context, query string, bindVars
 		return ExplainQueryResult{}, response.AsArangoErrorWithCode(code)
 	}
 }
+
+func (d databaseQuery) CompareQueryPlans(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (QueryPlanComparison, error) {
+	var explainOpts ExplainQueryOptions
+	if opts != nil {
+		explainOpts = *opts
+	}
+	explainOpts.AllPlans = true
+
+	explain, err := d.ExplainQuery(ctx, query, bindVars, &explainOpts)
+	if err != nil {
+		return QueryPlanComparison{}, err
+	}
+
+	plans := explain.Plans
+	if len(plans) == 0 {
+		plans = []ExplainQueryResultPlan{explain.Plan}
+	}
+
+	sort.SliceStable(plans, func(i, j int) bool {
+		return plans[i].EstimatedCost < plans[j].EstimatedCost
+	})
+
+	// Count in how many plans each rule is applied.
+	applied := map[string]int{}
+	for _, plan := range plans {
+		seen := map[string]bool{}
+		for _, rule := range plan.Rules {
+			if !seen[rule] {
+				seen[rule] = true
+				applied[rule]++
+			}
+		}
+	}
+
+	var rules []string
+	for rule, count := range applied {
+		if count < len(plans) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Strings(rules)
+
+	return QueryPlanComparison{
+		Plans:                plans,
+		DifferentiatingRules: rules,
+		Warnings:             explain.Warnings,
+	}, nil
+}
+
+func (d databaseQuery) QueryCacheProperties(ctx context.Context) (QueryCacheProperties, error) {
+	url := d.db.url("_api", "query-cache", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryCacheProperties  `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return QueryCacheProperties{}, err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryCacheProperties, nil
+	default:
+		return QueryCacheProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) SetQueryCacheProperties(ctx context.Context, props QueryCacheProperties) (QueryCacheProperties, error) {
+	url := d.db.url("_api", "query-cache", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryCacheProperties  `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, d.db.connection(), url, &response, props, d.db.modifiers...)
+	if err != nil {
+		return QueryCacheProperties{}, err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryCacheProperties, nil
+	default:
+		return QueryCacheProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) ClearQueryCache(ctx context.Context) error {
+	url := d.db.url("_api", "query-cache")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) QueryCacheEntries(ctx context.Context) ([]QueryCacheEntry, error) {
+	url := d.db.url("_api", "query-cache", "entries")
+
+	var result []QueryCacheEntry
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, url, &result, []int{http.StatusOK}, d.db.modifiers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (d databaseQuery) RunningQueries(ctx context.Context) ([]QueryEntry, error) {
+	return d.queries(ctx, "current")
+}
+
+func (d databaseQuery) SlowQueries(ctx context.Context) ([]QueryEntry, error) {
+	return d.queries(ctx, "slow")
+}
+
+func (d databaseQuery) queries(ctx context.Context, list string) ([]QueryEntry, error) {
+	url := d.db.url("_api", "query", list)
+
+	var result []QueryEntry
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, url, &result, []int{http.StatusOK}, d.db.modifiers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (d databaseQuery) OptimizerRules(ctx context.Context) ([]OptimizerRule, error) {
+	url := d.db.url("_api", "query", "rules")
+
+	var result []OptimizerRule
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, url, &result, []int{http.StatusOK}, d.db.modifiers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (d databaseQuery) KillQuery(ctx context.Context, id string) error {
+	url := d.db.url("_api", "query", id)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) QueryTrackingProperties(ctx context.Context) (QueryTrackingProperties, error) {
+	url := d.db.url("_api", "query", "properties")
+
+	var response struct {
+		shared.ResponseStruct   `json:",inline"`
+		QueryTrackingProperties `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return QueryTrackingProperties{}, err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryTrackingProperties, nil
+	default:
+		return QueryTrackingProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) SetQueryTrackingProperties(ctx context.Context, props QueryTrackingProperties) (QueryTrackingProperties, error) {
+	url := d.db.url("_api", "query", "properties")
+
+	var response struct {
+		shared.ResponseStruct   `json:",inline"`
+		QueryTrackingProperties `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, d.db.connection(), url, &response, props, d.db.modifiers...)
+	if err != nil {
+		return QueryTrackingProperties{}, err
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryTrackingProperties, nil
+	default:
+		return QueryTrackingProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
@@ -0,0 +1,188 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_databaseQuery_BatchQuery(t *testing.T) {
+	var received []BatchQueryRequest
+	var attempts int
+
+	conn := newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_db/db/_api/batch", r.URL.Path)
+
+		// The first attempt is rejected, so the body must be sent again by the retry wrapper.
+		attempts++
+		if attempts == 1 {
+			w.Header().Set(connection.ContentType, connection.ApplicationJSON)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":true,"code":503,"errorNum":503,"errorMessage":"service unavailable"}`))
+			return
+		}
+
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get(connection.ContentType))
+		require.NoError(t, err)
+		require.Equal(t, "multipart/form-data", mediaType)
+
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			require.Equal(t, batchPartContentType, part.Header.Get(connection.ContentType))
+
+			req, err := http.ReadRequest(bufio.NewReader(part))
+			require.NoError(t, err)
+			require.Equal(t, http.MethodPost, req.Method)
+			require.Equal(t, "/_api/cursor", req.URL.Path)
+
+			var request BatchQueryRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+			received = append(received, request)
+		}
+
+		parts := []string{
+			"HTTP/1.1 201 Created\r\nContent-Type: application/json\r\n\r\n" +
+				`{"error":false,"code":201,"hasMore":false,"result":[1,2,3]}`,
+			"HTTP/1.1 400 Bad Request\r\nContent-Type: application/json\r\n\r\n" +
+				`{"error":true,"code":400,"errorNum":1501,"errorMessage":"syntax error"}`,
+			"HTTP/1.1 201 Created\r\nContent-Type: application/json\r\n\r\n" +
+				`{"error":false,"code":201,"hasMore":false,"result":["a"]}`,
+		}
+
+		w.Header().Set(connection.ContentType, "multipart/form-data; boundary="+params["boundary"])
+		writer := multipart.NewWriter(w)
+		require.NoError(t, writer.SetBoundary(params["boundary"]))
+		for i, p := range parts {
+			part, err := writer.CreatePart(map[string][]string{
+				connection.ContentType: {batchPartContentType},
+				"Content-Id":           {fmt.Sprint(i)},
+			})
+			require.NoError(t, err)
+			_, err = part.Write([]byte(p))
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.Close())
+	})
+	db := newDatabase(newClient(connection.RetryOn503(conn, 2)), "db")
+
+	requests := []BatchQueryRequest{
+		{Query: "FOR i IN 1..@n RETURN i", BindVars: map[string]interface{}{"n": float64(3)}},
+		{Query: "FOR"},
+		{Query: "RETURN @v", BindVars: map[string]interface{}{"v": "a"}},
+	}
+
+	responses, err := db.BatchQuery(context.Background(), requests)
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, requests, received)
+	require.Len(t, responses, 3)
+
+	require.NoError(t, responses[0].Err)
+	var numbers []int
+	for responses[0].Cursor.HasMore() {
+		var i int
+		_, err := responses[0].Cursor.ReadDocument(context.Background(), &i)
+		require.NoError(t, err)
+		numbers = append(numbers, i)
+	}
+	require.Equal(t, []int{1, 2, 3}, numbers)
+
+	require.Nil(t, responses[1].Cursor)
+	require.Error(t, responses[1].Err)
+	require.True(t, strings.Contains(responses[1].Err.Error(), "syntax error"))
+
+	require.NoError(t, responses[2].Err)
+	var value string
+	_, err = responses[2].Cursor.ReadDocument(context.Background(), &value)
+	require.NoError(t, err)
+	require.Equal(t, "a", value)
+}
+
+func Test_databaseQuery_CompareQueryPlans(t *testing.T) {
+	var request struct {
+		Query    string                 `json:"query"`
+		BindVars map[string]interface{} `json:"bindVars"`
+		Opts     ExplainQueryOptions    `json:"options"`
+	}
+
+	db := newTestDatabase(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_db/db/_api/explain", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		w.Header().Set(connection.ContentType, "application/json")
+		w.Write([]byte(`{"error":false,"code":200,"warnings":["w"],"plans":[
+			{"rules":["move-filters-up","use-index-for-sort"],"estimatedCost":20.5},
+			{"rules":["move-filters-up","use-indexes"],"estimatedCost":3.5},
+			{"rules":["move-filters-up"],"estimatedCost":100}
+		]}`))
+	})
+
+	comparison, err := db.CompareQueryPlans(context.Background(), "FOR d IN c FILTER d.a == @a RETURN d", map[string]interface{}{"a": 1},
+		&ExplainQueryOptions{MaxNumberOfPlans: utils.NewType(3)})
+	require.NoError(t, err)
+
+	require.True(t, request.Opts.AllPlans)
+	require.Equal(t, utils.NewType(3), request.Opts.MaxNumberOfPlans)
+	require.Equal(t, "FOR d IN c FILTER d.a == @a RETURN d", request.Query)
+	require.Equal(t, map[string]interface{}{"a": float64(1)}, request.BindVars)
+
+	require.Len(t, comparison.Plans, 3)
+	require.Equal(t, []float64{3.5, 20.5, 100}, []float64{
+		comparison.Plans[0].EstimatedCost, comparison.Plans[1].EstimatedCost, comparison.Plans[2].EstimatedCost,
+	})
+	require.Equal(t, []string{"use-index-for-sort", "use-indexes"}, comparison.DifferentiatingRules)
+	require.Equal(t, []string{"w"}, comparison.Warnings)
+
+	cheapest, ok := comparison.Cheapest()
+	require.True(t, ok)
+	require.Equal(t, 3.5, cheapest.EstimatedCost)
+	require.Equal(t, []string{"use-indexes"}, comparison.DifferentiatingRulesOf(0))
+	require.Empty(t, comparison.DifferentiatingRulesOf(2))
+	require.Nil(t, comparison.DifferentiatingRulesOf(3))
+
+	t.Run("without options", func(t *testing.T) {
+		request.Opts = ExplainQueryOptions{}
+
+		_, err := db.CompareQueryPlans(context.Background(), "RETURN 1", nil, nil)
+		require.NoError(t, err)
+		require.True(t, request.Opts.AllPlans)
+		require.Nil(t, request.Opts.MaxNumberOfPlans)
+	})
+}
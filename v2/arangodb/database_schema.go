@@ -0,0 +1,85 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// DatabaseSchema captures the schema of a database, e.g. to detect drifts between environments.
+type DatabaseSchema interface {
+	// SchemaSnapshot returns the definitions of all user collections (with their properties and indexes),
+	// analyzers, graphs and views of the database.
+	// Identifiers which are specific to the deployment are not part of the snapshot,
+	// so snapshots of different databases can be compared with DiffSchema.
+	SchemaSnapshot(ctx context.Context) (SchemaSnapshot, error)
+}
+
+// SchemaSnapshot is a serializable snapshot of the database schema.
+// All lists are sorted by name.
+type SchemaSnapshot struct {
+	Collections []CollectionSchemaSnapshot `json:"collections"`
+	Analyzers   []AnalyzerDefinition       `json:"analyzers"`
+	Graphs      []GraphDefinition          `json:"graphs"`
+	Views       []ViewDefinition           `json:"views"`
+}
+
+// CollectionSchemaSnapshot contains the definition of a single collection.
+type CollectionSchemaSnapshot struct {
+	// Name of the collection.
+	Name string `json:"name"`
+
+	// Properties of the collection, including the computed values and the document schema.
+	Properties CollectionProperties `json:"properties"`
+
+	// Indexes of the collection sorted by name.
+	Indexes []IndexResponse `json:"indexes"`
+}
+
+// SchemaDiff contains the differences between two schema snapshots.
+type SchemaDiff struct {
+	Collections SchemaObjectDiff `json:"collections"`
+	Analyzers   SchemaObjectDiff `json:"analyzers"`
+	Graphs      SchemaObjectDiff `json:"graphs"`
+	Views       SchemaObjectDiff `json:"views"`
+}
+
+// IsEmpty returns true when both snapshots are the same.
+func (s SchemaDiff) IsEmpty() bool {
+	return s.Collections.IsEmpty() && s.Analyzers.IsEmpty() && s.Graphs.IsEmpty() && s.Views.IsEmpty()
+}
+
+// SchemaObjectDiff contains the names of the objects of one kind which differ between two schema snapshots.
+type SchemaObjectDiff struct {
+	// Added contains the objects which exist only in the second snapshot.
+	Added []string `json:"added,omitempty"`
+
+	// Removed contains the objects which exist only in the first snapshot.
+	Removed []string `json:"removed,omitempty"`
+
+	// Changed contains the objects which exist in both snapshots, but their definitions are different.
+	Changed []string `json:"changed,omitempty"`
+}
+
+// IsEmpty returns true when there are no differences.
+func (s SchemaObjectDiff) IsEmpty() bool {
+	return len(s.Added) == 0 && len(s.Removed) == 0 && len(s.Changed) == 0
+}
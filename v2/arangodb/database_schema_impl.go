@@ -0,0 +1,231 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseSchema(db *database) *databaseSchema {
+	return &databaseSchema{
+		db: db,
+	}
+}
+
+var _ DatabaseSchema = &databaseSchema{}
+
+type databaseSchema struct {
+	db *database
+}
+
+func (d databaseSchema) SchemaSnapshot(ctx context.Context) (SchemaSnapshot, error) {
+	var snapshot SchemaSnapshot
+	var err error
+
+	if snapshot.Collections, err = d.collections(ctx); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Analyzers, err = d.analyzers(ctx); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Graphs, err = d.graphs(ctx); err != nil {
+		return SchemaSnapshot{}, err
+	}
+
+	if snapshot.Views, err = d.db.ExportViews(ctx); err != nil {
+		return SchemaSnapshot{}, errors.WithStack(err)
+	}
+	sort.Slice(snapshot.Views, func(i, j int) bool {
+		return snapshot.Views[i].Name < snapshot.Views[j].Name
+	})
+
+	return snapshot, nil
+}
+
+// collections returns the definitions of all non-system collections.
+func (d databaseSchema) collections(ctx context.Context) ([]CollectionSchemaSnapshot, error) {
+	urlEndpoint := d.db.url("_api", "collection")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                []CollectionInfo `json:"result,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response, connection.WithQuery("excludeSystem", "true"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// Fallthrough.
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+
+	result := make([]CollectionSchemaSnapshot, 0, len(response.Result))
+	for _, info := range response.Result {
+		if info.IsSystem {
+			continue
+		}
+
+		col := newCollection(d.db, info.Name)
+
+		props, err := col.Properties(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		props.ID = ""
+		props.GloballyUniqueId = ""
+		props.Status = 0
+		props.StatusString = ""
+
+		indexes, err := col.Indexes(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for i := range indexes {
+			indexes[i].ID = ""
+		}
+		sort.Slice(indexes, func(i, j int) bool {
+			return indexes[i].Name < indexes[j].Name
+		})
+
+		result = append(result, CollectionSchemaSnapshot{
+			Name:       info.Name,
+			Properties: props,
+			Indexes:    indexes,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// analyzers returns the definitions of all analyzers with the names without the database prefix.
+func (d databaseSchema) analyzers(ctx context.Context) ([]AnalyzerDefinition, error) {
+	analyzers, err := d.db.AnalyzersAll(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make([]AnalyzerDefinition, len(analyzers))
+	for i, a := range analyzers {
+		result[i] = a.Definition()
+		result[i].Name = a.Name()
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func (d databaseSchema) graphs(ctx context.Context) ([]GraphDefinition, error) {
+	urlEndpoint := d.db.url("_api", "gharial")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Graphs                []GraphDefinition `json:"graphs,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		sort.Slice(response.Graphs, func(i, j int) bool {
+			return response.Graphs[i].Name < response.Graphs[j].Name
+		})
+		return response.Graphs, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// DiffSchema returns the differences between two schema snapshots.
+// Objects are matched by name, and their definitions are compared in the serialized form.
+func DiffSchema(a, b SchemaSnapshot) SchemaDiff {
+	return SchemaDiff{
+		Collections: diffSchemaObjects(schemaObjectsByName(a.Collections, func(c CollectionSchemaSnapshot) string { return c.Name }),
+			schemaObjectsByName(b.Collections, func(c CollectionSchemaSnapshot) string { return c.Name })),
+		Analyzers: diffSchemaObjects(schemaObjectsByName(a.Analyzers, func(c AnalyzerDefinition) string { return c.Name }),
+			schemaObjectsByName(b.Analyzers, func(c AnalyzerDefinition) string { return c.Name })),
+		Graphs: diffSchemaObjects(schemaObjectsByName(a.Graphs, func(c GraphDefinition) string { return c.Name }),
+			schemaObjectsByName(b.Graphs, func(c GraphDefinition) string { return c.Name })),
+		Views: diffSchemaObjects(schemaObjectsByName(a.Views, func(c ViewDefinition) string { return c.Name }),
+			schemaObjectsByName(b.Views, func(c ViewDefinition) string { return c.Name })),
+	}
+}
+
+// schemaObjectsByName returns the serialized objects by their names.
+func schemaObjectsByName[T any](objects []T, name func(T) string) map[string][]byte {
+	result := make(map[string][]byte, len(objects))
+	for _, o := range objects {
+		data, err := json.Marshal(o)
+		if err != nil {
+			// Objects which can not be serialized are always reported as changed.
+			data = nil
+		}
+		result[name(o)] = data
+	}
+	return result
+}
+
+func diffSchemaObjects(a, b map[string][]byte) SchemaObjectDiff {
+	var diff SchemaObjectDiff
+
+	for name, dataA := range a {
+		dataB, ok := b[name]
+		if !ok {
+			diff.Removed = append(diff.Removed, name)
+		} else if dataA == nil || dataB == nil || !bytes.Equal(dataA, dataB) {
+			diff.Changed = append(diff.Changed, name)
+		}
+	}
+
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+
+	return diff
+}
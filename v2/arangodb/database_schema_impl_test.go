@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffSchema(t *testing.T) {
+	a := SchemaSnapshot{
+		Collections: []CollectionSchemaSnapshot{
+			{Name: "same"},
+			{Name: "changed", Indexes: []IndexResponse{{Name: "primary", Type: PrimaryIndexType}}},
+			{Name: "removed"},
+		},
+		Analyzers: []AnalyzerDefinition{{Name: "text_en", Type: ArangoSearchAnalyzerTypeText}},
+		Views:     []ViewDefinition{{Name: "view", Type: ViewTypeSearchAlias}},
+	}
+
+	b := SchemaSnapshot{
+		Collections: []CollectionSchemaSnapshot{
+			{Name: "same"},
+			{Name: "changed", Indexes: []IndexResponse{{Name: "primary", Type: PrimaryIndexType}, {Name: "idx", Type: PersistentIndexType}}},
+			{Name: "added"},
+		},
+		Analyzers: []AnalyzerDefinition{{Name: "text_en", Type: ArangoSearchAnalyzerTypeText}},
+		Graphs:    []GraphDefinition{{Name: "graph"}},
+	}
+
+	diff := DiffSchema(a, b)
+	require.False(t, diff.IsEmpty())
+	require.Equal(t, SchemaObjectDiff{Added: []string{"added"}, Removed: []string{"removed"}, Changed: []string{"changed"}}, diff.Collections)
+	require.True(t, diff.Analyzers.IsEmpty())
+	require.Equal(t, SchemaObjectDiff{Added: []string{"graph"}}, diff.Graphs)
+	require.Equal(t, SchemaObjectDiff{Removed: []string{"view"}}, diff.Views)
+
+	require.True(t, DiffSchema(a, a).IsEmpty())
+}
@@ -22,6 +22,9 @@ package arangodb
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 // DatabaseTransaction contains Streaming Transactions functions
@@ -35,6 +38,55 @@ type DatabaseTransaction interface {
 	Transaction(ctx context.Context, id TransactionID) (Transaction, error)
 
 	WithTransaction(ctx context.Context, cols TransactionCollections, opts *BeginTransactionOptions, commitOptions *CommitTransactionOptions, abortOptions *AbortTransactionOptions, w TransactionWrap) error
+
+	// MultiCollectionWrite executes all given write operations atomically within a single Stream Transaction.
+	// All collections touched by the operations are locked exclusively.
+	// The transaction is committed when all operations succeed, otherwise it is aborted and the first error is returned.
+	MultiCollectionWrite(ctx context.Context, ops []WriteOp) error
 }
 
 type TransactionWrap func(ctx context.Context, t Transaction) error
+
+// WriteOpType describes the type of write operation executed by DatabaseTransaction.MultiCollectionWrite.
+type WriteOpType string
+
+const (
+	// WriteOpTypeInsert creates a new document.
+	WriteOpTypeInsert WriteOpType = "insert"
+	// WriteOpTypeUpdate partially updates an existing document.
+	WriteOpTypeUpdate WriteOpType = "update"
+	// WriteOpTypeReplace replaces an existing document.
+	WriteOpTypeReplace WriteOpType = "replace"
+	// WriteOpTypeDelete removes an existing document.
+	WriteOpTypeDelete WriteOpType = "delete"
+)
+
+// WriteOp describes a single write operation executed by DatabaseTransaction.MultiCollectionWrite.
+type WriteOp struct {
+	// Collection is the name of the collection the operation is executed on.
+	Collection string
+
+	// Type of the operation.
+	Type WriteOpType
+
+	// Key of the document. It is required for update, replace and delete operations.
+	Key string
+
+	// Document is the document body. It is ignored for delete operations.
+	Document interface{}
+}
+
+func (w WriteOp) validate() error {
+	if w.Collection == "" {
+		return shared.InvalidArgumentError{Message: "collection is empty"}
+	}
+
+	switch w.Type {
+	case WriteOpTypeInsert:
+		return nil
+	case WriteOpTypeUpdate, WriteOpTypeReplace, WriteOpTypeDelete:
+		return validateKey(w.Key)
+	default:
+		return shared.InvalidArgumentError{Message: fmt.Sprintf("unknown write operation type '%s'", w.Type)}
+	}
+}
@@ -119,6 +119,52 @@ func (d databaseTransaction) withTransactionPanic(ctx context.Context, cols Tran
 	return
 }
 
+func (d databaseTransaction) MultiCollectionWrite(ctx context.Context, ops []WriteOp) error {
+	var cols TransactionCollections
+	seen := map[string]struct{}{}
+
+	for i, op := range ops {
+		if err := op.validate(); err != nil {
+			return errors.Wrapf(err, "invalid write operation %d", i)
+		}
+
+		if _, ok := seen[op.Collection]; !ok {
+			seen[op.Collection] = struct{}{}
+			cols.Exclusive = append(cols.Exclusive, op.Collection)
+		}
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	return d.WithTransaction(ctx, cols, nil, nil, nil, func(ctx context.Context, t Transaction) error {
+		for i, op := range ops {
+			col, err := t.GetCollection(ctx, op.Collection, &GetCollectionOptions{SkipExistCheck: true})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			switch op.Type {
+			case WriteOpTypeInsert:
+				_, err = col.CreateDocument(ctx, op.Document)
+			case WriteOpTypeUpdate:
+				_, err = col.UpdateDocument(ctx, op.Key, op.Document)
+			case WriteOpTypeReplace:
+				_, err = col.ReplaceDocument(ctx, op.Key, op.Document)
+			case WriteOpTypeDelete:
+				_, err = col.DeleteDocument(ctx, op.Key)
+			}
+
+			if err != nil {
+				return errors.Wrapf(err, "write operation %d (%s in %s) failed", i, op.Type, op.Collection)
+			}
+		}
+
+		return nil
+	})
+}
+
 func (d databaseTransaction) BeginTransaction(ctx context.Context, cols TransactionCollections, opts *BeginTransactionOptions) (Transaction, error) {
 	url := d.db.url("_api", "transaction", "begin")
 
@@ -88,6 +88,8 @@ type TransactionCollections struct {
 	Write []string `json:"write,omitempty"`
 
 	// Collections that the transaction writes exclusively to.
+	// The exclusive lock is acquired when the transaction begins, so concurrent transactions on the same collection
+	// are serialized instead of failing with write-write conflicts.
 	Exclusive []string `json:"exclusive,omitempty"`
 }
 
@@ -144,6 +146,7 @@ type TransactionJSOptions struct {
 	LockTimeout *int `json:"lockTimeout,omitempty"`
 
 	// Optional arguments passed to action.
+	// The whole list is handed over as the single argument of the action function, e.g. `function (params) { return params[0]; }`.
 	Params []string `json:"params,omitempty"`
 
 	// Transaction size limit in bytes. Honored by the RocksDB storage engine only.
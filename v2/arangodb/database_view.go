@@ -44,6 +44,31 @@ type DatabaseView interface {
 	// CreateArangoSearchAliasView creates ArangoSearch alias view with given name and options, and opens a connection to it.
 	// If a view with given name already exists within the database, a ConflictError is returned.
 	CreateArangoSearchAliasView(ctx context.Context, name string, options *ArangoSearchAliasViewProperties) (ArangoSearchViewAlias, error)
+
+	// ExportViews returns the definitions of all views in the database.
+	// The definitions can be restored with ImportView, e.g. in another database or deployment.
+	ExportViews(ctx context.Context) ([]ViewDefinition, error)
+
+	// ImportView creates the view from the given definition.
+	// When a view with the same name already exists, its properties are replaced with the ones from the definition.
+	// If the existing view has a different type, an InvalidArgumentError is returned.
+	ImportView(ctx context.Context, def ViewDefinition) error
+}
+
+// ViewDefinition is a portable definition of a view.
+// Exactly one of the properties fields is set, depending on the type of the view.
+type ViewDefinition struct {
+	// Name of the view.
+	Name string `json:"name"`
+
+	// Type of the view.
+	Type ViewType `json:"type"`
+
+	// ArangoSearch contains the properties of a view of type ViewTypeArangoSearch.
+	ArangoSearch *ArangoSearchViewProperties `json:"arangoSearch,omitempty"`
+
+	// SearchAlias contains the properties of a view of type ViewTypeSearchAlias.
+	SearchAlias *ArangoSearchAliasViewProperties `json:"searchAlias,omitempty"`
 }
 
 type ViewsResponseReader interface {
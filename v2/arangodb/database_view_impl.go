@@ -22,6 +22,7 @@ package arangodb
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -203,6 +204,115 @@ func (d databaseView) CreateArangoSearchAliasView(ctx context.Context, name stri
 	}
 }
 
+func (d databaseView) ExportViews(ctx context.Context) ([]ViewDefinition, error) {
+	views, err := d.ViewsAll(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make([]ViewDefinition, 0, len(views))
+	for _, v := range views {
+		def := ViewDefinition{
+			Name: v.Name(),
+			Type: v.Type(),
+		}
+
+		switch v.Type() {
+		case ViewTypeArangoSearch:
+			asView, err := v.ArangoSearchView()
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			props, err := asView.Properties(ctx)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			// Identifiers are specific to the deployment, so they are not exported.
+			props.ViewBase = ViewBase{}
+			def.ArangoSearch = &props
+		case ViewTypeSearchAlias:
+			aliasView, err := v.ArangoSearchViewAlias()
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			props, err := aliasView.Properties(ctx)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			props.ViewBase = ViewBase{}
+			def.SearchAlias = &props
+		default:
+			return nil, errors.WithStack(shared.InvalidArgumentError{Message: fmt.Sprintf("unsupported type '%s' of view '%s'", v.Type(), v.Name())})
+		}
+
+		result = append(result, def)
+	}
+
+	return result, nil
+}
+
+func (d databaseView) ImportView(ctx context.Context, def ViewDefinition) error {
+	if def.Name == "" {
+		return errors.WithStack(shared.InvalidArgumentError{Message: "view name must not be empty"})
+	}
+
+	exists, err := d.ViewExists(ctx, def.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var existing View
+	if exists {
+		if existing, err = d.View(ctx, def.Name); err != nil {
+			return errors.WithStack(err)
+		}
+		if existing.Type() != def.Type {
+			return errors.WithStack(shared.InvalidArgumentError{
+				Message: fmt.Sprintf("view '%s' already exists with type '%s'", def.Name, existing.Type()),
+			})
+		}
+	}
+
+	switch def.Type {
+	case ViewTypeArangoSearch:
+		var props ArangoSearchViewProperties
+		if def.ArangoSearch != nil {
+			props = *def.ArangoSearch
+		}
+		props.ViewBase = ViewBase{}
+
+		if existing == nil {
+			_, err := d.CreateArangoSearchView(ctx, def.Name, &props)
+			return errors.WithStack(err)
+		}
+
+		asView, err := existing.ArangoSearchView()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(asView.SetProperties(ctx, props))
+	case ViewTypeSearchAlias:
+		var props ArangoSearchAliasViewProperties
+		if def.SearchAlias != nil {
+			props = *def.SearchAlias
+		}
+		props.ViewBase = ViewBase{}
+
+		if existing == nil {
+			_, err := d.CreateArangoSearchAliasView(ctx, def.Name, &props)
+			return errors.WithStack(err)
+		}
+
+		aliasView, err := existing.ArangoSearchViewAlias()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(aliasView.SetProperties(ctx, props))
+	default:
+		return errors.WithStack(shared.InvalidArgumentError{Message: fmt.Sprintf("unsupported view type '%s'", def.Type)})
+	}
+}
+
 func newViewsResponseReader(db *database, arr *connection.Array) ViewsResponseReader {
 	return &viewsResponseReader{
 		array: arr,
@@ -0,0 +1,109 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Document is a document of type T together with its meta data.
+// In JSON, the meta data fields (`_key`, `_id`, `_rev`) are stored alongside the fields of T,
+// so a Document can be passed directly to e.g. Collection.ReadDocument or Cursor.ReadDocument.
+// T must be encoded as a JSON object.
+type Document[T any] struct {
+	DocumentMeta
+	Data T
+}
+
+// MarshalJSON encodes the data and the non-empty meta data fields into a single JSON object.
+func (d Document[T]) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(d.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if string(data) != "null" {
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, errors.Wrapf(err, "document data must be a JSON object")
+		}
+	}
+
+	meta := map[string]string{
+		"_key": d.Key,
+		"_id":  string(d.ID),
+		"_rev": d.Rev,
+	}
+	for name, value := range meta {
+		if value == "" {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = raw
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes the meta data fields and the data from the same JSON object.
+func (d *Document[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &d.DocumentMeta); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &d.Data)
+}
+
+// ReadTypedDocument reads a single document with the given key from the collection.
+func ReadTypedDocument[T any](ctx context.Context, col Collection, key string) (Document[T], error) {
+	if col == nil {
+		return Document[T]{}, errors.New("collection can not be nil")
+	}
+
+	var doc Document[T]
+	if _, err := col.ReadDocument(ctx, key, &doc); err != nil {
+		return Document[T]{}, err
+	}
+
+	return doc, nil
+}
+
+// CreateTypedDocument creates a single document in the collection.
+// The returned document contains the meta data assigned by the server and the data as stored.
+func CreateTypedDocument[T any](ctx context.Context, col Collection, data T) (Document[T], error) {
+	if col == nil {
+		return Document[T]{}, errors.New("collection can not be nil")
+	}
+
+	doc := Document[T]{Data: data}
+	resp, err := col.CreateDocumentWithOptions(ctx, data, &CollectionDocumentCreateOptions{NewObject: &doc.Data})
+	if err != nil {
+		return Document[T]{}, err
+	}
+	doc.DocumentMeta = resp.DocumentMeta
+
+	return doc, nil
+}
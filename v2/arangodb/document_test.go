@@ -0,0 +1,68 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type documentTestData struct {
+	Name string `json:"name"`
+	Age  int    `json:"age,omitempty"`
+}
+
+func Test_Document_JSON(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		doc := Document[documentTestData]{
+			DocumentMeta: DocumentMeta{Key: "john", ID: "users/john", Rev: "_abc"},
+			Data:         documentTestData{Name: "John", Age: 21},
+		}
+
+		data, err := json.Marshal(doc)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"_key":"john","_id":"users/john","_rev":"_abc","name":"John","age":21}`, string(data))
+
+		var decoded Document[documentTestData]
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, doc, decoded)
+	})
+
+	t.Run("empty meta data is omitted", func(t *testing.T) {
+		data, err := json.Marshal(Document[documentTestData]{Data: documentTestData{Name: "John"}})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"name":"John"}`, string(data))
+	})
+
+	t.Run("map data", func(t *testing.T) {
+		var decoded Document[map[string]interface{}]
+		require.NoError(t, json.Unmarshal([]byte(`{"_key":"k","value":1}`), &decoded))
+		require.Equal(t, "k", decoded.Key)
+		require.Equal(t, float64(1), decoded.Data["value"])
+	})
+
+	t.Run("data which is not an object", func(t *testing.T) {
+		_, err := json.Marshal(Document[int]{Data: 5})
+		require.Error(t, err)
+	})
+}
@@ -20,7 +20,14 @@
 
 package arangodb
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 // Graph provides access to all edge & vertex collections of a single graph in a database.
 type Graph interface {
@@ -61,6 +68,8 @@ type Graph interface {
 	WriteConcern() *int
 
 	// Remove the entire graph with options.
+	// When RemoveGraphOptions.DropCollections is set, the collections of the graph which are not used in other graphs
+	// are dropped as well. If some of them can not be dropped, a GraphCollectionsNotRemovedError is returned.
 	Remove(ctx context.Context, opts *RemoveGraphOptions) error
 
 	// GraphVertexCollections - Vertex collection functions
@@ -72,9 +81,40 @@ type Graph interface {
 
 type RemoveGraphOptions struct {
 	// Drop the collections of this graph as well. Collections are only dropped if they are not used in other graphs.
+	// The current edge definitions and orphan collections of the graph are used, not the ones it was opened with.
 	DropCollections bool
 }
 
+// GraphCollectionsNotRemovedError is returned when the graph is removed, but some of its collections are not dropped.
+type GraphCollectionsNotRemovedError struct {
+	// Graph is the name of the removed graph.
+	Graph string
+	// Errors contains the error of each collection which is not dropped.
+	Errors map[string]error
+}
+
+// Error implements the error interface.
+func (g GraphCollectionsNotRemovedError) Error() string {
+	names := make([]string, 0, len(g.Errors))
+	for name := range g.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	failures := make([]string, len(names))
+	for i, name := range names {
+		failures[i] = fmt.Sprintf("%s: %s", name, g.Errors[name])
+	}
+
+	return fmt.Sprintf("graph '%s' is removed, but its collections are not dropped: %s", g.Graph, strings.Join(failures, "; "))
+}
+
+// IsGraphCollectionsNotRemoved returns true if the given error is a GraphCollectionsNotRemovedError.
+func IsGraphCollectionsNotRemoved(err error) bool {
+	var e GraphCollectionsNotRemovedError
+	return errors.As(err, &e)
+}
+
 type GraphDefinition struct {
 	Name string `json:"name"`
 
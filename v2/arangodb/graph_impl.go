@@ -100,6 +100,16 @@ func (g *graph) WriteConcern() *int {
 }
 
 func (g *graph) Remove(ctx context.Context, opts *RemoveGraphOptions) error {
+	var collections []string
+	if opts != nil && opts.DropCollections {
+		// The graph could have been modified since it was opened.
+		current, err := g.db.Graph(ctx, g.Name(), nil)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		collections = graphCollectionNames(current)
+	}
+
 	var response struct {
 		shared.ResponseStruct `json:",inline"`
 	}
@@ -111,10 +121,95 @@ func (g *graph) Remove(ctx context.Context, opts *RemoveGraphOptions) error {
 
 	switch code := resp.Code(); code {
 	case http.StatusOK, http.StatusAccepted:
-		return nil
 	default:
 		return response.AsArangoErrorWithCode(code)
 	}
+
+	if len(collections) == 0 {
+		return nil
+	}
+
+	return g.dropRemainingCollections(ctx, collections)
+}
+
+// dropRemainingCollections drops the given collections which are still present after the graph was removed
+// and are not used in other graphs.
+func (g *graph) dropRemainingCollections(ctx context.Context, collections []string) error {
+	graphs, err := g.db.Graphs(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	used := map[string]bool{}
+	for {
+		other, err := graphs.Read()
+		if shared.IsNoMoreDocuments(err) {
+			break
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for _, name := range graphCollectionNames(other) {
+			used[name] = true
+		}
+	}
+
+	remaining := map[string]error{}
+	for _, name := range collections {
+		if !used[name] {
+			remaining[name] = nil
+		}
+	}
+
+	// Collections which follow the sharding of another collection must be dropped first,
+	// so the collections are dropped in rounds as long as there is a progress.
+	for len(remaining) > 0 {
+		dropped := 0
+		for name := range remaining {
+			col, err := g.db.GetCollection(ctx, name, nil)
+			if err == nil {
+				err = col.Remove(ctx)
+			}
+
+			if err == nil || shared.IsNotFound(err) {
+				delete(remaining, name)
+				dropped++
+				continue
+			}
+			remaining[name] = err
+		}
+
+		if dropped == 0 {
+			return errors.WithStack(GraphCollectionsNotRemovedError{Graph: g.Name(), Errors: remaining})
+		}
+	}
+
+	return nil
+}
+
+// graphCollectionNames returns the names of the edge and vertex collections of the graph.
+func graphCollectionNames(g Graph) []string {
+	seen := map[string]bool{}
+	var names []string
+
+	add := func(collections ...string) {
+		for _, name := range collections {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	for _, e := range g.EdgeDefinitions() {
+		add(e.Collection)
+		add(e.From...)
+		add(e.To...)
+	}
+	add(g.OrphanCollections()...)
+
+	return names
 }
 
 func (o *RemoveGraphOptions) modifyRequest(r connection.Request) error {
@@ -0,0 +1,55 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_graphCollectionNames(t *testing.T) {
+	g := &graph{input: GraphDefinition{
+		EdgeDefinitions: []EdgeDefinition{
+			{Collection: "knows", From: []string{"persons"}, To: []string{"persons"}},
+			{Collection: "lives", From: []string{"persons"}, To: []string{"cities"}},
+		},
+		OrphanCollections: []string{"countries"},
+	}}
+
+	require.Equal(t, []string{"knows", "persons", "lives", "cities", "countries"}, graphCollectionNames(g))
+	require.Empty(t, graphCollectionNames(&graph{}))
+}
+
+func Test_GraphCollectionsNotRemovedError(t *testing.T) {
+	err := GraphCollectionsNotRemovedError{
+		Graph: "social",
+		Errors: map[string]error{
+			"persons": errors.New("in use"),
+			"cities":  errors.New("forbidden"),
+		},
+	}
+
+	require.Equal(t, "graph 'social' is removed, but its collections are not dropped: cities: forbidden; persons: in use", err.Error())
+	require.True(t, IsGraphCollectionsNotRemoved(err))
+	require.False(t, IsGraphCollectionsNotRemoved(errors.New("other")))
+}
@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// newTestConnection starts the test server with the given handler and returns the HTTP connection to it.
+// The server is closed when the test finishes.
+func newTestConnection(t testing.TB, handler http.HandlerFunc) connection.Connection {
+	return newTestConnectionWithConfig(t, handler, connection.HttpConfiguration{})
+}
+
+// newTestConnectionWithConfig is like newTestConnection, but the connection is created with the given configuration.
+func newTestConnectionWithConfig(t testing.TB, handler http.HandlerFunc, config connection.HttpConfiguration) connection.Connection {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config.Endpoint = connection.NewRoundRobinEndpoints([]string{server.URL})
+	return connection.NewHttpConnection(config)
+}
+
+// newTestDatabase returns the "db" database served by the test server with the given handler.
+func newTestDatabase(t testing.TB, handler http.HandlerFunc) Database {
+	return newDatabase(newClient(newTestConnection(t, handler)), "db")
+}
+
+// newTestCollection returns the "col" collection served by the test server with the given handler.
+func newTestCollection(t testing.TB, handler http.HandlerFunc) Collection {
+	return getTestCollection(t, newTestDatabase(t, handler))
+}
+
+// getTestCollection returns the "col" collection of the database without checking if it exists.
+func getTestCollection(t testing.TB, db Database) Collection {
+	col, err := db.GetCollection(context.Background(), "col", &GetCollectionOptions{SkipExistCheck: true})
+	require.NoError(t, err)
+
+	return col
+}
+
+// requestRecorder records the queries of the requests and responds with the given status code and body.
+type requestRecorder struct {
+	queries []url.Values
+	code    int
+	body    string
+}
+
+func (r *requestRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.queries = append(r.queries, req.URL.Query())
+
+	w.Header().Set("Content-Type", connection.ApplicationJSON)
+	w.WriteHeader(r.code)
+	w.Write([]byte(r.body))
+}
@@ -42,6 +42,10 @@ type DocumentMeta struct {
 	Key string     `json:"_key,omitempty"`
 	ID  DocumentID `json:"_id,omitempty"`
 	Rev string     `json:"_rev,omitempty"`
+
+	// WasDirtyRead is set by the document reads when the document has been read from a shard replica,
+	// which may not be in sync with the leader (see CollectionDocumentReadOptions.AllowDirtyReads).
+	WasDirtyRead bool `json:"-"`
 }
 
 // validateKey returns an error if the given key is empty otherwise invalid.
@@ -21,10 +21,11 @@
 package arangodb
 
 const (
-	HeaderDirtyReads  = "x-arango-allow-dirty-read"
-	HeaderTransaction = "x-arango-trx-id"
-	HeaderIfMatch     = "If-Match"
-	HeaderIfNoneMatch = "If-None-Match"
+	HeaderDirtyReads         = "x-arango-allow-dirty-read"
+	HeaderPotentialDirtyRead = "x-arango-potential-dirty-read"
+	HeaderTransaction        = "x-arango-trx-id"
+	HeaderIfMatch            = "If-Match"
+	HeaderIfNoneMatch        = "If-None-Match"
 
 	QueryRev               = "rev"
 	QueryIgnoreRevs        = "ignoreRevs"
@@ -26,6 +26,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -62,6 +65,12 @@ const (
 	ErrClusterLeadershipChallengeOngoing          = 1495
 	ErrClusterNotLeader                           = 1496
 
+	// AQL errors
+	ErrQueryParse = 1501
+
+	// Schema validation errors
+	ErrValidationFailed = 1620
+
 	// User management errors
 	ErrUserDuplicate = 1702
 )
@@ -97,6 +106,57 @@ func (ae ArangoError) Temporary() bool {
 	return ae.HasError && ae.Code == http.StatusServiceUnavailable
 }
 
+// queryErrorPositionRegexp matches the position of the error in the AQL query error message, e.g. `at position 1:10`.
+var queryErrorPositionRegexp = regexp.MustCompile(`at position (\d+):(\d+)`)
+
+// QueryErrorLocation is the location of the error in the AQL query.
+type QueryErrorLocation struct {
+	// Line is the 1-based line number.
+	Line int
+	// Column is the 1-based column number.
+	Column int
+}
+
+// Offset returns the 0-based byte offset of the location in the given query.
+// It returns -1 if the location is outside the query.
+func (q QueryErrorLocation) Offset(query string) int {
+	offset := 0
+	for line := 1; line < q.Line; line++ {
+		i := strings.IndexByte(query[offset:], '\n')
+		if i < 0 {
+			return -1
+		}
+		offset += i + 1
+	}
+
+	offset += q.Column - 1
+	if q.Line < 1 || q.Column < 1 || offset > len(query) {
+		return -1
+	}
+
+	return offset
+}
+
+// QueryErrorLocation returns the location of the error in the AQL query, e.g. for syntax errors.
+// It returns false if the error message does not contain the location.
+func (ae ArangoError) QueryErrorLocation() (QueryErrorLocation, bool) {
+	m := queryErrorPositionRegexp.FindStringSubmatch(ae.ErrorMessage)
+	if m == nil {
+		return QueryErrorLocation{}, false
+	}
+
+	line, err := strconv.Atoi(m[1])
+	if err != nil {
+		return QueryErrorLocation{}, false
+	}
+	column, err := strconv.Atoi(m[2])
+	if err != nil {
+		return QueryErrorLocation{}, false
+	}
+
+	return QueryErrorLocation{Line: line, Column: column}, true
+}
+
 // newArangoError creates a new ArangoError with given values.
 func newArangoError(code, errorNum int, errorMessage string) error {
 	return ArangoError{
@@ -147,6 +207,39 @@ func IsArangoErrorWithErrorNum(err error, errorNum ...int) bool {
 	})
 }
 
+// IsQueryParseError returns true if the given error is an AQL query parse error.
+// Use GetQueryErrorLocation to find out where the error is located in the query.
+func IsQueryParseError(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrQueryParse)
+}
+
+// GetQueryErrorLocation returns the location of the error in the AQL query,
+// if the given error is an ArangoError which contains it.
+func GetQueryErrorLocation(err error) (QueryErrorLocation, bool) {
+	if ok, arangoErr := IsArangoError(err); ok {
+		return arangoErr.QueryErrorLocation()
+	}
+
+	return QueryErrorLocation{}, false
+}
+
+// IsReadOnly returns true if the given error is an ArangoError indicating that the server is in the read-only mode.
+func IsReadOnly(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrArangoReadOnly)
+}
+
+// IsWriteConcernNotMet returns true if the given error is an ArangoError indicating that a write
+// could not be replicated to enough in-sync followers to fulfill the write concern.
+func IsWriteConcernNotMet(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrClusterReplicationWriteConcernNotFulfilled)
+}
+
+// IsSchemaValidationFailed returns true if the given error is an ArangoError indicating that the document
+// does not match the schema of the collection.
+func IsSchemaValidationFailed(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrValidationFailed)
+}
+
 // IsInvalidRequest returns true if the given error is an ArangoError with code 400, indicating an invalid request.
 func IsInvalidRequest(err error) bool {
 	return IsArangoErrorWithCode(err, http.StatusBadRequest)
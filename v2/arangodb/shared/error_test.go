@@ -0,0 +1,54 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package shared
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArangoError_QueryErrorLocation(t *testing.T) {
+	query := "FOR d IN col\n  RETURN d.\n"
+	err := errors.WithStack(ArangoError{
+		HasError:     true,
+		Code:         400,
+		ErrorNum:     ErrQueryParse,
+		ErrorMessage: "AQL: syntax error, unexpected end of query string near '' at position 2:12 (while parsing)",
+	})
+
+	require.True(t, IsQueryParseError(err))
+
+	location, ok := GetQueryErrorLocation(err)
+	require.True(t, ok)
+	require.Equal(t, QueryErrorLocation{Line: 2, Column: 12}, location)
+	require.Equal(t, 24, location.Offset(query))
+
+	require.Equal(t, 0, QueryErrorLocation{Line: 1, Column: 1}.Offset(query))
+	require.Equal(t, -1, QueryErrorLocation{Line: 4, Column: 1}.Offset(query))
+	require.Equal(t, -1, QueryErrorLocation{Line: 1, Column: 0}.Offset(query))
+
+	_, ok = GetQueryErrorLocation(ArangoError{ErrorMessage: "collection or view not found"})
+	require.False(t, ok)
+	_, ok = GetQueryErrorLocation(errors.New("at position 1:1"))
+	require.False(t, ok)
+}
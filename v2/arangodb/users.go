@@ -47,6 +47,19 @@ type ClientUsers interface {
 
 	// RemoveUser removes an existing user.
 	RemoveUser(ctx context.Context, name string) error
+
+	// GetDatabaseAccess returns the access level the given user has to the given database.
+	// It is a shortcut for User.GetDatabaseAccess, which does not require to fetch the user first.
+	GetDatabaseAccess(ctx context.Context, user, db string) (Grant, error)
+
+	// SetDatabaseAccess sets the access the given user has to the given database.
+	// It is a shortcut for User.SetDatabaseAccess, which does not require to fetch the user first.
+	// Use "*" as the database name to set the default access to any database.
+	SetDatabaseAccess(ctx context.Context, user, db string, access Grant) error
+
+	// SetCollectionAccess sets the access the given user has to a collection of the given database.
+	// It is a shortcut for User.SetCollectionAccess, which does not require to fetch the user first.
+	SetCollectionAccess(ctx context.Context, user, db, col string, access Grant) error
 }
 
 // UserOptions contains options for creating a new user, updating or replacing a user.
@@ -207,3 +207,15 @@ func (c clientUser) RemoveUser(ctx context.Context, name string) error {
 		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
 	}
 }
+
+func (c clientUser) GetDatabaseAccess(ctx context.Context, user, db string) (Grant, error) {
+	return newUser(c.client, &userResponse{Name: user}).GetDatabaseAccess(ctx, db)
+}
+
+func (c clientUser) SetDatabaseAccess(ctx context.Context, user, db string, access Grant) error {
+	return newUser(c.client, &userResponse{Name: user}).SetDatabaseAccess(ctx, db, access)
+}
+
+func (c clientUser) SetCollectionAccess(ctx context.Context, user, db, col string, access Grant) error {
+	return newUser(c.client, &userResponse{Name: user}).SetCollectionAccess(ctx, db, col, access)
+}
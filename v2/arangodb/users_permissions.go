@@ -36,7 +36,7 @@ type UserPermissions interface {
 	GetCollectionAccess(ctx context.Context, db, col string) (Grant, error)
 
 	// SetDatabaseAccess sets the access this user has to the given database.
-	// Pass a `nil` database to set the default access this user has to any new database.
+	// Use SetDefaultDatabaseAccess to set the default access this user has to any new database.
 	// You need the Administrate server access level
 	SetDatabaseAccess(ctx context.Context, db string, access Grant) error
 
@@ -44,6 +44,17 @@ type UserPermissions interface {
 	// You need the Administrate server access level
 	SetCollectionAccess(ctx context.Context, db, col string, access Grant) error
 
+	// SetDefaultDatabaseAccess sets the access this user has to all the databases without an explicit grant,
+	// including the databases which are created later. It sets the grant of the `*` database.
+	// You need the Administrate server access level
+	SetDefaultDatabaseAccess(ctx context.Context, access Grant) error
+
+	// SetDefaultCollectionAccess sets the access this user has to all the collections of the given database
+	// without an explicit grant, including the collections which are created later.
+	// It sets the grant of the `*` collection. Use `*` as the database to set it for all the databases.
+	// You need the Administrate server access level
+	SetDefaultCollectionAccess(ctx context.Context, db string, access Grant) error
+
 	// RemoveDatabaseAccess removes the access this user has to the given database.
 	// As a consequence, the default database access level is used.
 	// If there is no defined default database access level, it defaults to No access.
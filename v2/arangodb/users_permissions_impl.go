@@ -214,6 +214,14 @@ func (u user) RemoveDatabaseAccess(ctx context.Context, db string) error {
 	}
 }
 
+func (u user) SetDefaultDatabaseAccess(ctx context.Context, access Grant) error {
+	return u.SetDatabaseAccess(ctx, "*", access)
+}
+
+func (u user) SetDefaultCollectionAccess(ctx context.Context, db string, access Grant) error {
+	return u.SetCollectionAccess(ctx, db, "*", access)
+}
+
 func (u user) RemoveCollectionAccess(ctx context.Context, db, col string) error {
 	urlEndpoint := u.url("database", url.PathEscape(db), url.PathEscape(col))
 
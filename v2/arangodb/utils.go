@@ -28,10 +28,14 @@ import (
 	"reflect"
 
 	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
 )
 
 var _ json.Unmarshaler = &multiUnmarshaller{}
 var _ json.Marshaler = &multiUnmarshaller{}
+var _ connection.DecoderBinder = &multiUnmarshaller{}
 
 func newMultiUnmarshaller(obj ...interface{}) json.Unmarshaler {
 	return &multiUnmarshaller{
@@ -41,6 +45,13 @@ func newMultiUnmarshaller(obj ...interface{}) json.Unmarshaler {
 
 type multiUnmarshaller struct {
 	obj []interface{}
+
+	// decoder decodes the objects, encoding/json is used when it is nil.
+	decoder connection.Decoder
+}
+
+func (m *multiUnmarshaller) BindDecoder(decoder connection.Decoder) {
+	m.decoder = decoder
 }
 
 func (m multiUnmarshaller) MarshalJSON() ([]byte, error) {
@@ -65,7 +76,7 @@ func (m multiUnmarshaller) MarshalJSON() ([]byte, error) {
 
 func (m multiUnmarshaller) UnmarshalJSON(d []byte) error {
 	for _, o := range m.obj {
-		if err := json.Unmarshal(d, o); err != nil {
+		if err := connection.UnmarshalWith(m.decoder, d, o); err != nil {
 			return err
 		}
 	}
@@ -73,30 +84,47 @@ func (m multiUnmarshaller) UnmarshalJSON(d []byte) error {
 	return nil
 }
 
+var _ connection.DecoderBinder = &byteDecoder{}
+
 type byteDecoder struct {
 	data []byte
+
+	// decoder decodes the data, encoding/json is used when it is nil.
+	decoder connection.Decoder
 }
 
-func (b *byteDecoder) UnmarshalJSON(d []byte) error {
-	b.data = make([]byte, len(d))
+func (b *byteDecoder) BindDecoder(decoder connection.Decoder) {
+	b.decoder = decoder
+}
 
-	copy(b.data, d)
+// UnmarshalJSON copies the data into the decoder.
+// The memory of the previous data is reused when it is large enough.
+func (b *byteDecoder) UnmarshalJSON(d []byte) error {
+	b.data = append(b.data[:0], d...)
 
 	return nil
 }
 
 func (b *byteDecoder) Unmarshal(i interface{}) error {
-	return json.Unmarshal(b.data, i)
+	return connection.UnmarshalWith(b.decoder, b.data, i)
 }
 
 func newUnmarshalInto(obj interface{}) *UnmarshalInto {
-	return &UnmarshalInto{obj}
+	return &UnmarshalInto{obj: obj}
 }
 
 var _ json.Unmarshaler = &UnmarshalInto{}
+var _ connection.DecoderBinder = &UnmarshalInto{}
 
 type UnmarshalInto struct {
 	obj interface{}
+
+	// decoder decodes the object, encoding/json is used when it is nil.
+	decoder connection.Decoder
+}
+
+func (u *UnmarshalInto) BindDecoder(decoder connection.Decoder) {
+	u.decoder = decoder
 }
 
 func (u *UnmarshalInto) UnmarshalJSON(d []byte) error {
@@ -108,14 +136,27 @@ func (u *UnmarshalInto) UnmarshalJSON(d []byte) error {
 		return errors.Errorf("Unable to unmarshal into non ptr")
 	}
 
-	return json.Unmarshal(d, u.obj)
+	return connection.UnmarshalWith(u.decoder, d, u.obj)
 }
 
 var _ json.Unmarshaler = &jsonReader{}
+var _ connection.DecoderBinder = &jsonReader{}
 
 type jsonReader struct {
 	in       []byte
 	inStream *json.Decoder
+
+	// decoder decodes the elements, encoding/json is used when it is nil.
+	decoder connection.Decoder
+}
+
+func (j *jsonReader) BindDecoder(decoder connection.Decoder) {
+	j.decoder = decoder
+}
+
+// unmarshal decodes all the elements into i.
+func (j *jsonReader) unmarshal(i interface{}) error {
+	return connection.UnmarshalWith(j.decoder, j.in, i)
 }
 
 func (j *jsonReader) UnmarshalJSON(d []byte) error {
@@ -137,7 +178,16 @@ func (j *jsonReader) Read(i interface{}) error {
 		return io.EOF
 	}
 
-	return j.inStream.Decode(i)
+	if j.decoder == nil {
+		return j.inStream.Decode(i)
+	}
+
+	var element json.RawMessage
+	if err := j.inStream.Decode(&element); err != nil {
+		return err
+	}
+
+	return connection.UnmarshalWith(j.decoder, element, i)
 }
 
 func (j *jsonReader) HasMore() bool {
@@ -167,3 +217,113 @@ func CreateDocuments(ctx context.Context, col Collection, docCount int, generato
 	_, err := col.CreateDocuments(ctx, docs)
 	return err
 }
+
+// UpdateWithRetry updates the document with the given key using optimistic locking.
+// It reads the current document, applies modify to it and updates the document only if its revision
+// has not changed in the meantime. In case of a revision conflict, it starts over at most maxRetries times.
+// The document is patched, so the stored attributes which are not present in T are kept.
+// The error returned by modify aborts the update.
+func UpdateWithRetry[T any](ctx context.Context, col Collection, key string, modify func(current T) (T, error),
+	maxRetries int) (CollectionDocumentUpdateResponse, error) {
+	if modify == nil {
+		return CollectionDocumentUpdateResponse{}, errors.New("modify function can not be nil")
+	}
+	if col == nil {
+		return CollectionDocumentUpdateResponse{}, errors.New("collection can not be nil")
+	}
+
+	for attempt := 0; ; attempt++ {
+		var current T
+		meta, err := col.ReadDocument(ctx, key, &current)
+		if err != nil {
+			return CollectionDocumentUpdateResponse{}, err
+		}
+
+		modified, err := modify(current)
+		if err != nil {
+			return CollectionDocumentUpdateResponse{}, err
+		}
+
+		resp, err := col.UpdateDocumentWithOptions(ctx, key, modified, &CollectionDocumentUpdateOptions{
+			IfMatch: meta.Rev,
+		})
+		if err == nil {
+			return resp, nil
+		}
+
+		if !shared.IsPreconditionFailed(err) || attempt >= maxRetries {
+			return CollectionDocumentUpdateResponse{}, err
+		}
+	}
+}
+
+// QueryAll runs the query with the given bind parameters and decodes all the result documents into a slice of T.
+func QueryAll[T any](ctx context.Context, db DatabaseQuery, query string, bindVars map[string]interface{}) ([]T, error) {
+	if db == nil {
+		return nil, errors.New("database can not be nil")
+	}
+
+	cursor, err := db.Query(ctx, query, &QueryOptions{BindVars: bindVars})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	result := make([]T, 0)
+	for cursor.HasMore() {
+		var doc T
+		if _, err := cursor.ReadDocument(ctx, &doc); err != nil {
+			return nil, err
+		}
+		result = append(result, doc)
+	}
+
+	return result, nil
+}
+
+// QueryStream runs the query with the given bind parameters and sends the result documents decoded into T
+// to the returned channel. The documents channel is closed when all the documents have been sent,
+// or when an error occurs. In the latter case the error is sent to the errors channel before it is closed.
+// The consumer which stops reading the documents must cancel the context, so the cursor is closed.
+func QueryStream[T any](ctx context.Context, db DatabaseQuery, query string,
+	bindVars map[string]interface{}) (<-chan T, <-chan error) {
+	docs := make(chan T)
+	errs := make(chan error, 1)
+
+	if db == nil {
+		errs <- errors.New("database can not be nil")
+		close(docs)
+		close(errs)
+		return docs, errs
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(docs)
+
+		cursor, err := db.Query(ctx, query, &QueryOptions{BindVars: bindVars})
+		if err != nil {
+			errs <- err
+			return
+		}
+		// The cursor is closed with the new context, because the given context may be already canceled.
+		defer cursor.CloseWithContext(context.Background())
+
+		for cursor.HasMore() {
+			var doc T
+			if _, err := cursor.ReadDocument(ctx, &doc); err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case docs <- doc:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return docs, errs
+}
@@ -0,0 +1,155 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+type nameMapperDoc struct {
+	UserName  string
+	HomeCity  string
+	LoginCode int `json:"code"`
+}
+
+// Test_NameMapper_ReadPath checks that the documents in the responses are decoded with the NameMapper of the connection.
+func Test_NameMapper_ReadPath(t *testing.T) {
+	doc := `{"_key":"john","_id":"col/john","_rev":"_r","user_name":"John","home_city":"Cologne","code":7}`
+	expected := nameMapperDoc{UserName: "John", HomeCity: "Cologne", LoginCode: 7}
+
+	conn := newTestConnectionWithConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/document/col/john":
+			w.Write([]byte(doc))
+		case r.Method == http.MethodPut && r.URL.Path == "/_db/db/_api/document/col":
+			w.Write([]byte(`[` + doc + `,` + doc + `]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/_db/db/_api/document/col":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"_key":"john","_id":"col/john","_rev":"_r","new":` + doc + `}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/_db/db/_api/cursor":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"error":false,"code":201,"hasMore":false,"result":[` + doc + `,` + doc + `]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, connection.HttpConfiguration{NameMapper: connection.SnakeCaseNameMapper})
+	db := newDatabase(newClient(conn), "db")
+	col := getTestCollection(t, db)
+
+	t.Run("read document", func(t *testing.T) {
+		var result nameMapperDoc
+		meta, err := col.ReadDocument(context.Background(), "john", &result)
+		require.NoError(t, err)
+		require.Equal(t, "john", meta.Key)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("read documents", func(t *testing.T) {
+		reader, err := col.ReadDocuments(context.Background(), []string{"john", "john"})
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			var result nameMapperDoc
+			meta, err := reader.Read(&result)
+			require.NoError(t, err)
+			require.Equal(t, "john", meta.Key)
+			require.Equal(t, expected, result)
+		}
+	})
+
+	t.Run("returned new document", func(t *testing.T) {
+		var result nameMapperDoc
+		_, err := col.CreateDocumentWithOptions(context.Background(), expected, &CollectionDocumentCreateOptions{
+			NewObject: &result,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		var all []nameMapperDoc
+		batch, err := db.QueryBatch(context.Background(), "FOR d IN users RETURN d", nil, &all)
+		require.NoError(t, err)
+		defer batch.Close()
+		require.Equal(t, []nameMapperDoc{expected, expected}, all)
+
+		cursor, err := db.Query(context.Background(), "FOR d IN users RETURN d", nil)
+		require.NoError(t, err)
+		defer cursor.Close()
+
+		var result nameMapperDoc
+		meta, err := cursor.ReadDocument(context.Background(), &result)
+		require.NoError(t, err)
+		require.Equal(t, "john", meta.Key)
+		require.Equal(t, expected, result)
+	})
+}
+
+func Test_UpdateWithRetry(t *testing.T) {
+	type partialDoc struct {
+		Count int `json:"count"`
+	}
+
+	var attempts int
+	col := newTestCollection(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/_db/db/_api/document/col/k":
+			w.Write([]byte(`{"_key":"k","_id":"col/k","_rev":"_r` + strconv.Itoa(attempts) + `","count":1,"other":2}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/_db/db/_api/document/col/k":
+			attempts++
+			require.Equal(t, "_r"+strconv.Itoa(attempts-1), r.Header.Get(HeaderIfMatch))
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, map[string]interface{}{"count": float64(2)}, body)
+
+			if attempts == 1 {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				w.Write([]byte(`{"error":true,"code":412,"errorNum":1200,"errorMessage":"conflict"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"_key":"k","_id":"col/k","_rev":"_new","_oldRev":"_r1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	resp, err := UpdateWithRetry(context.Background(), col, "k", func(current partialDoc) (partialDoc, error) {
+		current.Count++
+		return current, nil
+	}, 1)
+	require.NoError(t, err)
+	require.Equal(t, "_new", resp.Rev)
+	require.Equal(t, 2, attempts)
+}
@@ -105,3 +105,46 @@ func (v Version) CompareTo(other Version) int {
 	}
 	return 0
 }
+
+// Feature is a server feature which is available starting from a specific version.
+type Feature string
+
+const (
+	// FeatureInvertedIndex is the support of the inverted index.
+	FeatureInvertedIndex Feature = "inverted-index"
+	// FeatureSearchAlias is the support of the search-alias views.
+	FeatureSearchAlias Feature = "search-alias"
+	// FeatureComputedValues is the support of the computed values of collections.
+	FeatureComputedValues Feature = "computed-values"
+	// FeatureCursorRetry is the support of retrying the cursor batch reads (the `allowRetry` query option).
+	FeatureCursorRetry Feature = "cursor-retry"
+	// FeatureMDIIndex is the support of the multi-dimensional (mdi) index.
+	// Older servers support the zkd index only.
+	FeatureMDIIndex Feature = "mdi-index"
+)
+
+// featureVersions maps the features to the minimum versions which support them.
+var featureVersions = map[Feature]Version{
+	FeatureInvertedIndex:  "3.10.0",
+	FeatureSearchAlias:    "3.10.0",
+	FeatureComputedValues: "3.10.0",
+	FeatureCursorRetry:    "3.11.0",
+	FeatureMDIIndex:       "3.12.0",
+}
+
+// MinVersion returns the minimum version which supports the feature.
+// The bool return value is false if the feature is unknown.
+func (f Feature) MinVersion() (Version, bool) {
+	v, ok := featureVersions[f]
+	return v, ok
+}
+
+// SupportsFeature returns true if the version supports the given feature.
+// It returns false for unknown features.
+func (v Version) SupportsFeature(feature Feature) bool {
+	minVersion, ok := feature.MinVersion()
+	if !ok {
+		return false
+	}
+	return v.CompareTo(minVersion) >= 0
+}
@@ -0,0 +1,63 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Version_SupportsFeature(t *testing.T) {
+	tests := []struct {
+		version  Version
+		feature  Feature
+		expected bool
+	}{
+		{version: "3.9.10", feature: FeatureInvertedIndex, expected: false},
+		{version: "3.10.0", feature: FeatureInvertedIndex, expected: true},
+		{version: "3.10.0-devel", feature: FeatureSearchAlias, expected: true},
+		{version: "3.11.5", feature: FeatureComputedValues, expected: true},
+		{version: "3.10.13", feature: FeatureCursorRetry, expected: false},
+		{version: "3.11.0", feature: FeatureCursorRetry, expected: true},
+		{version: "3.11.14", feature: FeatureMDIIndex, expected: false},
+		{version: "3.12.2", feature: FeatureMDIIndex, expected: true},
+		{version: "4.0.0", feature: FeatureMDIIndex, expected: true},
+		{version: "3.12.2", feature: Feature("unknown"), expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.version)+"/"+string(tc.feature), func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.version.SupportsFeature(tc.feature))
+		})
+	}
+}
+
+func Test_Feature_MinVersion(t *testing.T) {
+	for feature, expected := range featureVersions {
+		v, ok := feature.MinVersion()
+		require.True(t, ok)
+		require.Equal(t, expected, v)
+	}
+
+	_, ok := Feature("unknown").MinVersion()
+	require.False(t, ok)
+}
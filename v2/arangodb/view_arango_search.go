@@ -37,6 +37,57 @@ type ArangoSearchView interface {
 
 	// UpdateProperties Partially changes the properties of a View by updating the specified attributes.
 	UpdateProperties(ctx context.Context, options ArangoSearchViewProperties) error
+
+	// Stats fetches the runtime statistics of the view (segments, documents, index size).
+	// The statistics are collected from the figures of all links of the view,
+	// so collections which are not linked to the view are not taken into account.
+	Stats(ctx context.Context) (ViewStats, error)
+}
+
+// ViewStats contains the runtime statistics of an ArangoSearch view.
+type ViewStats struct {
+	// ViewLinkStats contains the statistics summed up over all links of the view.
+	ViewLinkStats `json:",inline"`
+
+	// Links contains the statistics per linked collection.
+	// The key of the map is the collection name.
+	Links map[string]ViewLinkStats `json:"links,omitempty"`
+}
+
+// ViewLinkStats contains the figures of a single ArangoSearch view link.
+type ViewLinkStats struct {
+	// NumDocs is the number of documents (including removed ones) stored in the segments.
+	NumDocs int64 `json:"numDocs"`
+
+	// NumLiveDocs is the number of documents which are not removed.
+	NumLiveDocs int64 `json:"numLiveDocs"`
+
+	// NumPrimaryDocs is the number of primary documents (nested documents are not counted).
+	NumPrimaryDocs int64 `json:"numPrimaryDocs"`
+
+	// NumSegments is the number of segments.
+	NumSegments int64 `json:"numSegments"`
+
+	// NumFiles is the number of files.
+	NumFiles int64 `json:"numFiles"`
+
+	// IndexSize is the size of the index in bytes.
+	IndexSize int64 `json:"indexSize"`
+}
+
+// NumDeadDocs returns the number of removed documents which are still stored in the segments
+// and which are going to be cleaned up by the consolidation.
+func (v ViewLinkStats) NumDeadDocs() int64 {
+	return v.NumDocs - v.NumLiveDocs
+}
+
+func (v *ViewLinkStats) add(o ViewLinkStats) {
+	v.NumDocs += o.NumDocs
+	v.NumLiveDocs += o.NumLiveDocs
+	v.NumPrimaryDocs += o.NumPrimaryDocs
+	v.NumSegments += o.NumSegments
+	v.NumFiles += o.NumFiles
+	v.IndexSize += o.IndexSize
 }
 
 // ArangoSearchViewProperties contains properties of view with type 'arangosearch'
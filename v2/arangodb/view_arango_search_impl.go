@@ -92,3 +92,68 @@ func (v *viewArangoSearch) UpdateProperties(ctx context.Context, options ArangoS
 		return response.AsArangoErrorWithCode(code)
 	}
 }
+
+func (v *viewArangoSearch) Stats(ctx context.Context) (ViewStats, error) {
+	props, err := v.Properties(ctx)
+	if err != nil {
+		return ViewStats{}, errors.WithStack(err)
+	}
+
+	stats := ViewStats{
+		Links: make(map[string]ViewLinkStats, len(props.Links)),
+	}
+
+	for colName := range props.Links {
+		linkStats, err := v.linkStats(ctx, colName, props.ViewBase)
+		if err != nil {
+			return ViewStats{}, errors.WithStack(err)
+		}
+
+		stats.Links[colName] = linkStats
+		stats.add(linkStats)
+	}
+
+	return stats, nil
+}
+
+// linkStats fetches the figures of the link between the view and the given collection.
+// Links are reported by the server as hidden indexes of the collection.
+func (v *viewArangoSearch) linkStats(ctx context.Context, colName string, base ViewBase) (ViewLinkStats, error) {
+	urlEndpoint := v.db.url("_api", "index")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Indexes               []struct {
+			Type    string         `json:"type"`
+			View    string         `json:"view,omitempty"`
+			Figures *ViewLinkStats `json:"figures,omitempty"`
+		} `json:"indexes,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, v.db.connection(), urlEndpoint, &response,
+		connection.WithQuery("collection", colName),
+		connection.WithQuery("withStats", "true"),
+		connection.WithQuery("withHidden", "true"))
+	if err != nil {
+		return ViewLinkStats{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// Fallthrough.
+	default:
+		return ViewLinkStats{}, response.AsArangoErrorWithCode(code)
+	}
+
+	for _, idx := range response.Indexes {
+		if idx.Type != string(ViewTypeArangoSearch) || idx.Figures == nil {
+			continue
+		}
+
+		if idx.View == base.ID || idx.View == base.GloballyUniqueId {
+			return *idx.Figures, nil
+		}
+	}
+
+	return ViewLinkStats{}, nil
+}
@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/utils/jwt"
+)
+
+const (
+	// defaultSuperUserTokenServerID is the server ID which is put into the superuser tokens, when none is given.
+	defaultSuperUserTokenServerID = "go-driver"
+	// superUserTokenValidity is the time after which the superuser token expires.
+	superUserTokenValidity = time.Hour
+	// superUserTokenRefreshMargin is the time before the expiration when the superuser token is regenerated.
+	superUserTokenRefreshMargin = time.Minute
+)
+
+// NewSuperUserAuthentication creates an authentication which signs its own superuser JWT token
+// with the JWT secret of the server, instead of obtaining a token from the `/_open/auth` endpoint.
+// The token is short-lived, and it is regenerated before it expires.
+// The serverID is put into the `server_id` claim of the token, e.g. to identify the client in the server logs.
+// When it is empty, "go-driver" is used.
+func NewSuperUserAuthentication(secret []byte, serverID string) Authentication {
+	if serverID == "" {
+		serverID = defaultSuperUserTokenServerID
+	}
+
+	return &superUserAuth{
+		secret:   secret,
+		serverID: serverID,
+		now:      time.Now,
+	}
+}
+
+type superUserAuth struct {
+	secret   []byte
+	serverID string
+	now      func() time.Time
+
+	mutex     sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func (s *superUserAuth) RequestModifier(r Request) error {
+	token, err := s.getToken()
+	if err != nil {
+		return err
+	}
+
+	r.AddHeader("Authorization", token)
+
+	return nil
+}
+
+// getToken returns the authorization header with the current token, or generates a new one when it is about to expire.
+func (s *superUserAuth) getToken() (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Add(superUserTokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	if len(s.secret) == 0 {
+		return "", errors.Errorf("JWT secret is empty")
+	}
+
+	expiresAt := now.Add(superUserTokenValidity)
+	token, err := jwt.CreateArangodJwtAuthorizationHeaderExpiring(string(s.secret), s.serverID, now, expiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token
+	s.expiresAt = expiresAt
+
+	return s.token, nil
+}
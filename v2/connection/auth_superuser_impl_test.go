@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func parseSuperUserToken(t *testing.T, r Request, secret []byte) jwt.MapClaims {
+	header, ok := r.GetHeader("Authorization")
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(header, "bearer "))
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(strings.TrimPrefix(header, "bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+		require.Equal(t, jwt.SigningMethodHS256, token.Method)
+		return secret, nil
+	})
+	require.NoError(t, err)
+	require.True(t, token.Valid)
+
+	return claims
+}
+
+func Test_SuperUserAuthentication(t *testing.T) {
+	secret := []byte("secret")
+	auth := NewSuperUserAuthentication(secret, "")
+
+	r := &httpRequest{}
+	require.NoError(t, auth.RequestModifier(r))
+
+	claims := parseSuperUserToken(t, r, secret)
+	require.Equal(t, "arangodb", claims["iss"])
+	require.Equal(t, defaultSuperUserTokenServerID, claims["server_id"])
+	require.Contains(t, claims, "exp")
+
+	t.Run("Server ID", func(t *testing.T) {
+		r := &httpRequest{}
+		require.NoError(t, NewSuperUserAuthentication(secret, "my-client").RequestModifier(r))
+		require.Equal(t, "my-client", parseSuperUserToken(t, r, secret)["server_id"])
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		header, _ := r.GetHeader("Authorization")
+		_, err := jwt.Parse(strings.TrimPrefix(header, "bearer "), func(token *jwt.Token) (interface{}, error) {
+			return []byte("other"), nil
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Empty secret", func(t *testing.T) {
+		require.Error(t, NewSuperUserAuthentication(nil, "").RequestModifier(&httpRequest{}))
+	})
+}
+
+func Test_SuperUserAuthentication_Regenerate(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	auth := &superUserAuth{
+		secret:   secret,
+		serverID: defaultSuperUserTokenServerID,
+		now: func() time.Time {
+			return now
+		},
+	}
+
+	first := &httpRequest{}
+	require.NoError(t, auth.RequestModifier(first))
+	firstToken, _ := first.GetHeader("Authorization")
+
+	// The token is reused while it is valid.
+	now = now.Add(superUserTokenValidity / 2)
+	second := &httpRequest{}
+	require.NoError(t, auth.RequestModifier(second))
+	secondToken, _ := second.GetHeader("Authorization")
+	require.Equal(t, firstToken, secondToken)
+
+	// The token is regenerated before it expires.
+	now = now.Add(superUserTokenValidity/2 - superUserTokenRefreshMargin/2)
+	third := &httpRequest{}
+	require.NoError(t, auth.RequestModifier(third))
+	thirdToken, _ := third.GetHeader("Authorization")
+	require.NotEqual(t, firstToken, thirdToken)
+
+	jwt.TimeFunc = func() time.Time {
+		return now
+	}
+	defer func() {
+		jwt.TimeFunc = time.Now
+	}()
+
+	claims := parseSuperUserToken(t, third, secret)
+	require.EqualValues(t, now.Add(superUserTokenValidity).Unix(), claims["exp"])
+}
@@ -22,9 +22,14 @@ package connection
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 type RequestModifier func(r Request) error
@@ -34,7 +39,7 @@ func Call(ctx context.Context, c Connection, method, url string, output interfac
 }
 
 func CallWithChecks(ctx context.Context, c Connection, method, url string, output interface{}, allowedStatusCodes []int, modifiers ...RequestModifier) (Response, error) {
-	req, err := c.NewRequest(method, url)
+	req, err := newRequest(ctx, c, method, url)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +59,7 @@ func CallWithChecks(ctx context.Context, c Connection, method, url string, outpu
 // It returns the response and body reader to read the data from there.
 // The caller is responsible to free the response body.
 func CallStream(ctx context.Context, c Connection, method, url string, modifiers ...RequestModifier) (Response, io.ReadCloser, error) {
-	req, err := c.NewRequest(method, url)
+	req, err := newRequest(ctx, c, method, url)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -70,6 +75,27 @@ func CallStream(ctx context.Context, c Connection, method, url string, modifiers
 	return c.Stream(ctx, req)
 }
 
+// newRequest creates the request on the endpoint set with WithEndpoint in the context.
+// Otherwise, the endpoint is chosen by the connection.
+func newRequest(ctx context.Context, c Connection, method, url string) (Request, error) {
+	endpoint, ok := HasEndpoint(ctx)
+	if !ok {
+		return c.NewRequest(method, url)
+	}
+
+	if e := c.GetEndpoint(); e != nil {
+		for _, known := range e.List() {
+			if FixupEndpointURLScheme(known) == endpoint {
+				return c.NewRequestWithEndpoint(known, method, url)
+			}
+		}
+	}
+
+	return nil, errors.WithStack(shared.InvalidArgumentError{
+		Message: fmt.Sprintf("endpoint '%s' is not one of the configured endpoints", endpoint),
+	})
+}
+
 func CallGet(ctx context.Context, c Connection, url string, output interface{}, modifiers ...RequestModifier) (Response, error) {
 	return Call(ctx, c, http.MethodGet, url, output, modifiers...)
 }
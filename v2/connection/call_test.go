@@ -0,0 +1,193 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// newNamedServer starts a server which responds with its name to every request.
+func newNamedServer(t *testing.T, name string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"server":"` + name + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_CallWithEndpoint(t *testing.T) {
+	serverA := newNamedServer(t, "a")
+	serverB := newNamedServer(t, "b")
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint: NewRoundRobinEndpoints([]string{serverA.URL, serverB.URL}),
+	})
+
+	serverName := func(ctx context.Context) (string, error) {
+		var response struct {
+			Server string `json:"server"`
+		}
+		_, err := CallGet(ctx, conn, "_api/version", &response)
+		return response.Server, err
+	}
+
+	t.Run("round robin without endpoint", func(t *testing.T) {
+		names := map[string]bool{}
+		for i := 0; i < 2; i++ {
+			name, err := serverName(context.Background())
+			require.NoError(t, err)
+			names[name] = true
+		}
+		require.Len(t, names, 2)
+	})
+
+	t.Run("pinned endpoint is used", func(t *testing.T) {
+		for server, expected := range map[*httptest.Server]string{serverA: "a", serverB: "b"} {
+			ctx := WithEndpoint(context.Background(), server.URL)
+			for i := 0; i < 3; i++ {
+				name, err := serverName(ctx)
+				require.NoError(t, err)
+				require.Equal(t, expected, name)
+			}
+		}
+	})
+
+	t.Run("endpoint with arangod scheme", func(t *testing.T) {
+		ctx := WithEndpoint(context.Background(), strings.Replace(serverB.URL, "http://", "tcp://", 1))
+
+		name, err := serverName(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "b", name)
+	})
+
+	t.Run("unknown endpoint", func(t *testing.T) {
+		ctx := WithEndpoint(context.Background(), "http://127.0.0.1:1")
+
+		_, err := serverName(ctx)
+		require.Error(t, err)
+		require.True(t, shared.IsInvalidArgument(err))
+	})
+}
+
+func Test_CallWithRequestHeaders(t *testing.T) {
+	var recorded http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.URL}),
+		Authentication: NewBasicAuth("root", "secret"),
+	})
+
+	t.Run("custom headers are sent", func(t *testing.T) {
+		ctx := WithRequestHeaders(context.Background(), map[string]string{
+			"X-Request-ID": "request-1",
+			"traceparent":  "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		})
+
+		_, err := CallGet(ctx, conn, "_api/version", nil)
+		require.NoError(t, err)
+		require.Equal(t, "request-1", recorded.Get("X-Request-ID"))
+		require.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", recorded.Get("Traceparent"))
+	})
+
+	t.Run("driver headers win", func(t *testing.T) {
+		ctx := WithRequestHeaders(context.Background(), map[string]string{
+			"authorization":   "bearer fake",
+			"x-arango-driver": "fake",
+		})
+
+		_, err := CallGet(ctx, conn, "_api/version", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Basic cm9vdDpzZWNyZXQ="}, recorded.Values("Authorization"))
+		require.Len(t, recorded.Values("X-Arango-Driver"), 1)
+		require.True(t, strings.HasPrefix(recorded.Get("X-Arango-Driver"), "go-driver-v2/"))
+	})
+
+	t.Run("no custom headers", func(t *testing.T) {
+		_, err := CallGet(context.Background(), conn, "_api/version", nil)
+		require.NoError(t, err)
+		require.Empty(t, recorded.Get("X-Request-ID"))
+	})
+}
+
+// headerRecordingTransport records the headers of the requests.
+type headerRecordingTransport struct {
+	headers http.Header
+}
+
+func (h *headerRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	h.headers = req.Header.Clone()
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{ContentType: []string{ApplicationJSON}},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func Test_CallDriverHeader(t *testing.T) {
+	call := func(t *testing.T, config ArangoDBConfiguration) http.Header {
+		transport := &headerRecordingTransport{}
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint:       NewRoundRobinEndpoints([]string{"http://127.0.0.1:8529"}),
+			Transport:      transport,
+			ArangoDBConfig: config,
+		})
+
+		_, err := CallGet(context.Background(), conn, "_api/version", nil)
+		require.NoError(t, err)
+		return transport.headers
+	}
+
+	t.Run("default", func(t *testing.T) {
+		headers := call(t, ArangoDBConfiguration{DriverFlags: []string{"flag"}})
+		require.True(t, strings.HasPrefix(headers.Get("X-Arango-Driver"), "go-driver-v2/"))
+		require.True(t, strings.HasSuffix(headers.Get("X-Arango-Driver"), " (flag)"))
+	})
+
+	t.Run("overridden", func(t *testing.T) {
+		headers := call(t, ArangoDBConfiguration{DriverHeader: "my-app/1.0", DriverFlags: []string{"flag"}})
+		require.Equal(t, []string{"my-app/1.0"}, headers.Values("X-Arango-Driver"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		headers := call(t, ArangoDBConfiguration{DisableDriverHeader: true, DriverHeader: "my-app/1.0"})
+		require.NotContains(t, headers, "X-Arango-Driver")
+		require.Equal(t, ApplicationJSON, headers.Get("Accept"))
+	})
+}
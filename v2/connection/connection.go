@@ -45,8 +45,28 @@ type ArangoDBConfiguration struct {
 	// DriverFlags configure additional flags for the `x-arango-driver` header
 	DriverFlags []string
 
+	// DriverHeader overrides the value of the `x-arango-driver` header, which identifies the driver.
+	// DriverFlags are not appended to it.
+	DriverHeader string
+
+	// DisableDriverHeader omits the `x-arango-driver` header, e.g. when it causes issues with a reverse proxy.
+	// The headers required by the protocol are still sent.
+	DisableDriverHeader bool
+
 	// Compression is used to enable compression between client and server
 	Compression *CompressionConfig
+
+	// Tracer is used to create a span for each request, e.g. with OpenTelemetry.
+	// Requests are not traced when it is nil.
+	Tracer Tracer
+
+	// RequestLogger is invoked once for every request with its method, URL, status code and duration.
+	// Requests are not logged when it is nil.
+	RequestLogger RequestLogger
+
+	// RequestLoggerBodies adds the request and the response bodies to the entries passed to RequestLogger.
+	// The response body is read entirely before the response is returned, so it should be enabled for debugging only.
+	RequestLoggerBodies bool
 }
 
 // CompressionConfig is used to enable compression for the connection
@@ -63,6 +83,12 @@ type CompressionConfig struct {
 	// RequestCompressionLevel - Sets the compression level between -1 and 9
 	// Default: 0 (NoCompression). For Reference see: https://pkg.go.dev/compress/flate#pkg-constants
 	RequestCompressionLevel int
+
+	// RequestCompressionMinSize is the minimum size in bytes of the encoded request body to be compressed.
+	// Smaller bodies are sent without compression, because it is not worth it.
+	// It is not used by HTTP2 connections, because they stream the request body.
+	// Default: 0 (all bodies are compressed).
+	RequestCompressionMinSize int
 }
 
 type CompressionType string
@@ -111,6 +137,11 @@ type Connection interface {
 
 	// SetConfiguration sets the configuration for the connection to database
 	SetConfiguration(config ArangoDBConfiguration)
+
+	// Close closes the connection. The new requests are rejected with ErrConnectionClosed,
+	// the in-flight requests are given the configured grace period to finish, then they are canceled.
+	// The response bodies which are not closed by the caller are not waited for after the grace period.
+	Close() error
 }
 
 type Request interface {
@@ -119,6 +150,11 @@ type Request interface {
 
 	Endpoint() string
 
+	// SetBody sets the body of the request.
+	// The body is encoded according to the content type of the connection.
+	// An io.Reader is sent as it is, e.g. to stream binary data, so the Content-Type header must be set accordingly.
+	// The *bytes.Buffer and io.Seeker bodies are rewound when the request is sent again, e.g. by the retry wrappers.
+	// The other readers can be sent only once, so the requests with them are not retried.
 	SetBody(i interface{}) error
 	AddHeader(key, value string)
 	AddQuery(key, value string)
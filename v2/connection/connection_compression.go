@@ -21,6 +21,7 @@
 package connection
 
 import (
+	"compress/gzip"
 	"compress/zlib"
 	"fmt"
 	"io"
@@ -62,16 +63,16 @@ func (g gzipCompression) ApplyRequestCompression(r *httpRequest, rootWriter io.W
 	config := g.config
 
 	if config != nil && config.RequestCompressionEnabled {
-		if config.CompressionType == "deflate" {
-			r.headers["Content-Encoding"] = "deflate"
+		if config.CompressionType == "gzip" {
+			r.headers["Content-Encoding"] = "gzip"
 
-			zlibWriter, err := zlib.NewWriterLevel(rootWriter, config.RequestCompressionLevel)
+			gzipWriter, err := gzip.NewWriterLevel(rootWriter, config.RequestCompressionLevel)
 			if err != nil {
-				log.Errorf(err, "error creating zlib writer")
+				log.Errorf(err, "error creating gzip writer")
 				return nil, err
 			}
 
-			return zlibWriter, nil
+			return gzipWriter, nil
 		}
 	}
 
@@ -0,0 +1,208 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest describes the request received by the recording server.
+type recordedRequest struct {
+	acceptEncoding  string
+	contentEncoding string
+	body            []byte
+}
+
+// newRecordingServer starts a server which decompresses the request body and responds with it.
+// The response is compressed with the given encoding.
+func newRecordingServer(t *testing.T, responseEncoding string, recorded *recordedRequest) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded.acceptEncoding = r.Header.Get("Accept-Encoding")
+		recorded.contentEncoding = r.Header.Get("Content-Encoding")
+
+		var reader io.Reader = r.Body
+		switch recorded.contentEncoding {
+		case "gzip":
+			gr, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			reader = gr
+		case "deflate":
+			zr, err := zlib.NewReader(r.Body)
+			require.NoError(t, err)
+			reader = zr
+		}
+
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		recorded.body = body
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodHead {
+			if responseEncoding != "" {
+				w.Header().Set("Content-Encoding", responseEncoding)
+			}
+			return
+		}
+
+		switch responseEncoding {
+		case "gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			gw := gzip.NewWriter(w)
+			defer gw.Close()
+			gw.Write(body)
+		case "deflate":
+			w.Header().Set("Content-Encoding", "deflate")
+			zw := zlib.NewWriter(w)
+			defer zw.Close()
+			zw.Write(body)
+		default:
+			w.Write(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_Compression(t *testing.T) {
+	type document struct {
+		Value string `json:"value"`
+	}
+
+	large := document{Value: strings.Repeat("a", 2048)}
+	small := document{Value: "a"}
+
+	tests := map[string]struct {
+		compression             *CompressionConfig
+		responseEncoding        string
+		body                    document
+		expectedAcceptEncoding  string
+		expectedRequestEncoding string
+	}{
+		"no compression": {
+			body: large,
+		},
+		"gzip": {
+			compression: &CompressionConfig{
+				CompressionType:            RequestCompressionTypeGzip,
+				RequestCompressionEnabled:  true,
+				ResponseCompressionEnabled: true,
+				RequestCompressionLevel:    gzip.BestSpeed,
+			},
+			responseEncoding:        "gzip",
+			body:                    large,
+			expectedAcceptEncoding:  "gzip",
+			expectedRequestEncoding: "gzip",
+		},
+		"deflate": {
+			compression: &CompressionConfig{
+				CompressionType:            RequestCompressionTypeDeflate,
+				RequestCompressionEnabled:  true,
+				ResponseCompressionEnabled: true,
+				RequestCompressionLevel:    zlib.BestSpeed,
+			},
+			responseEncoding:        "deflate",
+			body:                    large,
+			expectedAcceptEncoding:  "deflate",
+			expectedRequestEncoding: "deflate",
+		},
+		"small body is not compressed": {
+			compression: &CompressionConfig{
+				CompressionType:           RequestCompressionTypeGzip,
+				RequestCompressionEnabled: true,
+				RequestCompressionLevel:   gzip.BestSpeed,
+				RequestCompressionMinSize: 1024,
+			},
+			body: small,
+		},
+		"large body above the threshold is compressed": {
+			compression: &CompressionConfig{
+				CompressionType:           RequestCompressionTypeGzip,
+				RequestCompressionEnabled: true,
+				RequestCompressionLevel:   gzip.BestSpeed,
+				RequestCompressionMinSize: 1024,
+			},
+			body:                    large,
+			expectedRequestEncoding: "gzip",
+		},
+		"server ignores the accepted encoding": {
+			compression: &CompressionConfig{
+				CompressionType:            RequestCompressionTypeGzip,
+				ResponseCompressionEnabled: true,
+			},
+			body:                   large,
+			expectedAcceptEncoding: "gzip",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var recorded recordedRequest
+			server := newRecordingServer(t, test.responseEncoding, &recorded)
+
+			conn := NewHttpConnection(HttpConfiguration{
+				Endpoint:       NewRoundRobinEndpoints([]string{server.URL}),
+				ArangoDBConfig: ArangoDBConfiguration{Compression: test.compression},
+			})
+
+			var output document
+			_, err := CallPost(context.Background(), conn, "_api/document/c", &output, test.body)
+			require.NoError(t, err)
+			require.Equal(t, test.body, output, "response must be decompressed")
+
+			expectedBody, err := json.Marshal(test.body)
+			require.NoError(t, err)
+			require.Equal(t, string(expectedBody), string(bytes.TrimSpace(recorded.body)))
+			require.Equal(t, test.expectedRequestEncoding, recorded.contentEncoding)
+			if test.expectedAcceptEncoding != "" {
+				require.Equal(t, test.expectedAcceptEncoding, recorded.acceptEncoding)
+			}
+		})
+	}
+
+	t.Run("empty compressed response", func(t *testing.T) {
+		var recorded recordedRequest
+		server := newRecordingServer(t, "gzip", &recorded)
+
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint: NewRoundRobinEndpoints([]string{server.URL}),
+			ArangoDBConfig: ArangoDBConfiguration{Compression: &CompressionConfig{
+				CompressionType:            RequestCompressionTypeGzip,
+				ResponseCompressionEnabled: true,
+			}},
+		})
+
+		resp, err := CallHead(context.Background(), conn, "_api/document/c/k", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.Code())
+	})
+}
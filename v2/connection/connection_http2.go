@@ -21,6 +21,8 @@
 package connection
 
 import (
+	"time"
+
 	"golang.org/x/net/http2"
 )
 
@@ -32,7 +34,38 @@ type Http2Configuration struct {
 
 	ArangoDBConfig ArangoDBConfiguration
 
+	// NameMapper converts the names of the struct fields without an explicit name in the `json` tag
+	// into the JSON keys, e.g. SnakeCaseNameMapper. By default, the encoding/json behavior is used.
+	// It is applied only for the JSON content type, both to the requests and to the responses, including the documents.
+	// The types with their own JSON methods, e.g. arangodb.Document, are encoded and decoded by those methods.
+	NameMapper NameMapper
+
 	Transport *http2.Transport
+
+	// EndpointHealthCheck enables the background health checking of the endpoints.
+	// Endpoints which fail the health check are not used until they are healthy again.
+	// The endpoints set later with Connection.SetEndpoint are health-checked too.
+	// The health checking is stopped when the endpoints are replaced or the connection is closed.
+	EndpointHealthCheck *EndpointHealthCheckConfiguration
+
+	// AutoDiscoverEndpoints enables the discovery of the cluster coordinators with `/_api/cluster/endpoints`.
+	// The configured endpoints are used to bootstrap the discovery, then the requests are sent round-robin
+	// to the discovered coordinators. The endpoints are synchronized with SynchronizeEndpoints.
+	AutoDiscoverEndpoints bool
+
+	// EndpointDiscoveryInterval is the minimum interval between two discoveries of the endpoints.
+	// Default: 1 minute
+	EndpointDiscoveryInterval time.Duration
+
+	// MaxResponseBodySize is the maximum number of bytes read from the (decompressed) response body.
+	// When the response body is larger, the reading is aborted with a ResponseTooLargeError.
+	// Default: no limit
+	MaxResponseBodySize int64
+
+	// CloseGracePeriod is the maximum time Close waits for the in-flight requests to finish.
+	// The requests which are still in-flight after it are canceled.
+	// Default: the in-flight requests are canceled immediately.
+	CloseGracePeriod time.Duration
 }
 
 func (h Http2Configuration) getTransport() *http2.Transport {
@@ -52,12 +85,30 @@ func (h Http2Configuration) GetContentType() string {
 }
 
 func NewHttp2Connection(config Http2Configuration) Connection {
-	c := newHttpConnection(config.getTransport(), config.ContentType, config.Endpoint, config.ArangoDBConfig)
+	transport := config.getTransport()
+
+	endpoint := config.Endpoint
+
+	if h := config.EndpointHealthCheck; h != nil && endpoint != nil {
+		endpoint = NewHealthCheckEndpoints(endpoint, transport, *h)
+	}
+
+	c := newHttpConnection(transport, config.ContentType, endpoint, config.ArangoDBConfig)
+	c.healthCheck = config.EndpointHealthCheck
+	if config.AutoDiscoverEndpoints && endpoint != nil {
+		c.discovery = newEndpointDiscovery(config.EndpointDiscoveryInterval)
+	}
+	c.maxResponseBodySize = config.MaxResponseBodySize
+	c.closeGracePeriod = config.CloseGracePeriod
 
 	if a := config.Authentication; a != nil {
 		c.authentication = a
 	}
 
+	if m := config.NameMapper; m != nil {
+		c.jsonDecoder = newNameMapperJsonDecoder(m)
+	}
+
 	c.streamSender = true
 
 	return c
@@ -0,0 +1,156 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// newRequestTracker returns a tracker of the in-flight requests of a connection.
+func newRequestTracker() *requestTracker {
+	abort, cancel := context.WithCancel(context.Background())
+
+	return &requestTracker{
+		abort:       abort,
+		cancelAbort: cancel,
+	}
+}
+
+// requestTracker tracks the in-flight requests, so the connection can be closed gracefully.
+type requestTracker struct {
+	lock     sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+
+	// abort is canceled when the in-flight requests must be canceled.
+	abort       context.Context
+	cancelAbort context.CancelFunc
+}
+
+// begin registers a new in-flight request. The returned context is canceled when the request is aborted by close.
+// The returned function must be called when the request is finished, e.g. when its response body is closed.
+func (r *requestTracker) begin(ctx context.Context) (context.Context, func(), error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return nil, nil, errors.WithStack(ErrConnectionClosed)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	r.inFlight.Add(1)
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(r.abort, cancel)
+
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			stop()
+			cancel()
+			r.inFlight.Done()
+		})
+	}, nil
+}
+
+// close rejects the new requests and waits up to the grace period for the in-flight requests to finish.
+// The requests which are still in-flight after the grace period are canceled, but close does not wait for them
+// to finish, so the response bodies which are never closed by the caller do not block it.
+// It returns false if the tracker has been already closed.
+func (r *requestTracker) close(gracePeriod time.Duration) bool {
+	r.lock.Lock()
+	if r.closed {
+		r.lock.Unlock()
+		return false
+	}
+	r.closed = true
+	r.lock.Unlock()
+
+	if gracePeriod > 0 {
+		r.wait(gracePeriod)
+	}
+
+	r.cancelAbort()
+
+	return true
+}
+
+// wait waits up to the given timeout for the in-flight requests to finish.
+// The waiting goroutine ends as soon as the in-flight requests are finished or canceled,
+// but it is blocked forever by a response body which is never closed.
+func (r *requestTracker) wait(timeout time.Duration) {
+	finished := make(chan struct{})
+	go func() {
+		r.inFlight.Wait()
+		close(finished)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-finished:
+	case <-timer.C:
+	}
+}
+
+// trackedBody finishes the in-flight request when the response body is closed.
+type trackedBody struct {
+	io.ReadCloser
+
+	done func()
+}
+
+func (t *trackedBody) Close() error {
+	defer t.done()
+
+	return t.ReadCloser.Close()
+}
+
+// Close rejects the new requests with ErrConnectionClosed and waits up to the configured close grace period
+// for the in-flight requests to finish. The requests which are still in-flight after the grace period are canceled.
+// Finally, the discovery and the health checking of the endpoints are stopped and the idle connections of the transport are closed.
+// A request is in-flight until its response body is closed. Close does not wait for the response bodies
+// which are still open after the grace period, they are only canceled.
+func (j *httpConnection) Close() error {
+	if !j.requests.close(j.closeGracePeriod) {
+		return nil
+	}
+
+	if j.discovery != nil {
+		j.discovery.stop()
+	}
+
+	if h, ok := j.GetEndpoint().(HealthCheckEndpoint); ok {
+		h.Stop()
+	}
+
+	j.client.CloseIdleConnections()
+
+	return nil
+}
@@ -21,6 +21,7 @@
 package connection
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"compress/zlib"
@@ -32,6 +33,8 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -81,6 +84,7 @@ func newHttpConnection(t http.RoundTripper, contentType string, endpoint Endpoin
 		endpoint:    endpoint,
 		contentType: contentType,
 		config:      config,
+		requests:    newRequestTracker(),
 	}
 }
 
@@ -89,13 +93,33 @@ type httpConnection struct {
 
 	transport http.RoundTripper
 
+	// endpointLock guards the endpoint which can be replaced while the requests are sent.
+	endpointLock   sync.RWMutex
 	endpoint       Endpoint
 	authentication Authentication
 	contentType    string
 
+	// jsonDecoder overrides the default JSON decoder.
+	jsonDecoder Decoder
+
 	streamSender bool
 
 	config ArangoDBConfiguration
+
+	// healthCheck health-checks the endpoints set on the connection when the health checking is enabled.
+	healthCheck *EndpointHealthCheckConfiguration
+
+	// discovery refreshes the endpoints when the automatic discovery of the endpoints is enabled.
+	discovery *endpointDiscovery
+
+	// maxResponseBodySize is the maximum size of the response body. Zero means no limit.
+	maxResponseBodySize int64
+
+	// requests tracks the in-flight requests, so the connection can be closed gracefully.
+	requests *requestTracker
+
+	// closeGracePeriod is the maximum time Close waits for the in-flight requests.
+	closeGracePeriod time.Duration
 }
 
 func (j *httpConnection) GetAuthentication() Authentication {
@@ -111,25 +135,55 @@ func (j *httpConnection) SetAuthentication(a Authentication) error {
 // If the content type is unknown, then it returns default JSON decoder.
 func (j *httpConnection) Decoder(contentType string) Decoder {
 	// First, try to get decoder by the content type of the response.
-	if decoder := getDecoderByContentType(contentType); decoder != nil {
+	if decoder := j.getDecoderByContentType(contentType); decoder != nil {
 		return decoder
 	}
 
 	// Next, try to get decoder by the content type of the HTTP connection.
-	if decoder := getDecoderByContentType(j.contentType); decoder != nil {
+	if decoder := j.getDecoderByContentType(j.contentType); decoder != nil {
 		return decoder
 	}
 
 	// Return the default decoder.
-	return getJsonDecoder()
+	return j.getDecoderByContentType(ApplicationJSON)
+}
+
+// getDecoderByContentType returns the decoder according to the content type, taking the overridden JSON decoder into account.
+// If contentType is unknown, then nil is returned.
+func (j *httpConnection) getDecoderByContentType(contentType string) Decoder {
+	if contentType == ApplicationJSON && j.jsonDecoder != nil {
+		return j.jsonDecoder
+	}
+
+	return getDecoderByContentType(contentType)
 }
 
 func (j *httpConnection) GetEndpoint() Endpoint {
+	j.endpointLock.RLock()
+	defer j.endpointLock.RUnlock()
+
 	return j.endpoint
 }
 
+// SetEndpoint replaces the endpoints of the connection.
+// When the health checking is enabled, the new endpoints are health-checked,
+// and the health checking of the replaced endpoints is stopped.
 func (j *httpConnection) SetEndpoint(e Endpoint) error {
+	if h := j.healthCheck; h != nil && e != nil {
+		if _, ok := e.(HealthCheckEndpoint); !ok {
+			e = NewHealthCheckEndpoints(e, j.transport, *h)
+		}
+	}
+
+	j.endpointLock.Lock()
+	previous := j.endpoint
 	j.endpoint = e
+	j.endpointLock.Unlock()
+
+	if h, ok := previous.(HealthCheckEndpoint); ok && previous != e {
+		h.Stop()
+	}
+
 	return nil
 }
 
@@ -152,7 +206,11 @@ func (j *httpConnection) NewRequest(method string, urlParts ...string) (Request,
 func (j *httpConnection) newRequestWithEndpoint(endpoint string, method string, urlParts ...string) (*httpRequest, error) {
 	urlPath := path.Join(urlParts...)
 
-	e, err := j.endpoint.Get(endpoint, method, urlPath)
+	if j.discovery != nil {
+		j.discovery.refreshIfDue(j)
+	}
+
+	e, err := j.GetEndpoint().Get(endpoint, method, urlPath)
 	if err != nil {
 		return nil, errors.Errorf("Unable to resolve endpoint for %s", endpoint)
 	}
@@ -224,11 +282,42 @@ func (j *httpConnection) Stream(ctx context.Context, request Request) (Response,
 		return nil, nil, errors.Errorf("unable to parse request into JSON Request")
 	}
 
-	return j.stream(ctx, req)
+	ctx, done, err := j.requests.begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, body, err := j.stream(ctx, req)
+	if err != nil || body == nil {
+		// The response is returned together with the error, because the callers inspect its status code.
+		done()
+		return resp, body, err
+	}
+
+	return resp, &trackedBody{ReadCloser: body, done: done}, nil
 }
 
 // stream performs the HTTP request. It returns HTTP response and body reader to read the data from there.
+// The request is traced and logged when the tracer and the request logger are configured.
 func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpResponse, io.ReadCloser, error) {
+	doStream := j.doStream
+	if logger := j.config.RequestLogger; logger != nil {
+		doStream = func(ctx context.Context, req *httpRequest) (*httpResponse, io.ReadCloser, error) {
+			return j.logStream(ctx, logger, req, j.doStream)
+		}
+	}
+
+	if tracer := j.config.Tracer; tracer != nil {
+		return traceStream(ctx, tracer, req, func(ctx context.Context) (*httpResponse, io.ReadCloser, error) {
+			return doStream(ctx, req)
+		})
+	}
+
+	return doStream(ctx, req)
+}
+
+// doStream performs the HTTP request. It returns HTTP response and body reader to read the data from there.
+func (j *httpConnection) doStream(ctx context.Context, req *httpRequest) (*httpResponse, io.ReadCloser, error) {
 	id := uuid.New().String()
 	log.Debugf("(%s) Sending request to %s/%s", id, req.Method(), req.URL())
 	if v, ok := req.GetHeader(ContentType); !ok || v == "" {
@@ -244,6 +333,11 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 		}
 	}
 
+	if headers, ok := HasRequestHeaders(ctx); ok {
+		// Custom headers must not overwrite the headers set by the driver.
+		req.addMissingHeaders(headers)
+	}
+
 	var httpReq *http.Request
 
 	if ctx == nil {
@@ -257,6 +351,11 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 	}
 	httpReq = r
 
+	stats, withStats := HasRequestStats(ctx)
+	if withStats {
+		stats.countRequest(httpReq)
+	}
+
 	resp, err := j.client.Do(httpReq)
 	if err != nil {
 		log.Debugf("(%s) Request failed: %s", id, err.Error())
@@ -264,17 +363,21 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 	}
 	log.Debugf("(%s) Response received: %d", id, resp.StatusCode)
 
+	if withStats {
+		stats.countResponse(resp)
+	}
+
 	if b := resp.Body; b != nil {
 		var resultBody io.ReadCloser
 
-		respEncoding := resp.Header.Get("Content-Encoding")
-		switch respEncoding {
-		case "gzip":
-			resultBody, err = gzip.NewReader(resp.Body)
-		case "deflate":
-			resultBody, err = zlib.NewReader(resp.Body)
-		default:
-			resultBody = resp.Body
+		resultBody, err = decompressBody(resp)
+		if err != nil {
+			resp.Body.Close()
+			return &httpResponse{response: resp, request: req}, nil, errors.WithStack(err)
+		}
+
+		if j.maxResponseBodySize > 0 {
+			resultBody = &limitedBody{ReadCloser: resultBody, limit: j.maxResponseBodySize}
 		}
 
 		return &httpResponse{response: resp, request: req}, resultBody, nil
@@ -284,6 +387,75 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 	return &httpResponse{response: resp, request: req}, nil, nil
 }
 
+// decompressBody returns the reader of the response body according to its content encoding.
+// The server may ignore the accepted encoding, so the body is returned as it is when it is not compressed.
+func decompressBody(resp *http.Response) (io.ReadCloser, error) {
+	var newReader func(r io.Reader) (io.ReadCloser, error)
+	switch resp.Header.Get("Content-Encoding") {
+	case "gzip":
+		newReader = func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		}
+	case "deflate":
+		newReader = zlib.NewReader
+	default:
+		return resp.Body, nil
+	}
+
+	// The compressed body can be empty, e.g. for the HEAD requests.
+	br := bufio.NewReader(resp.Body)
+	if _, err := br.Peek(1); err == io.EOF {
+		return resp.Body, nil
+	}
+
+	r, err := newReader(br)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decompressedBody{ReadCloser: r, body: resp.Body}, nil
+}
+
+// decompressedBody closes both the decompressing reader and the original response body.
+type decompressedBody struct {
+	io.ReadCloser
+	body io.Closer
+}
+
+func (d *decompressedBody) Close() error {
+	err := d.ReadCloser.Close()
+	if errBody := d.body.Close(); err == nil {
+		err = errBody
+	}
+	return err
+}
+
+// limitedBody returns a ResponseTooLargeError when more than limit bytes are read from the response body.
+type limitedBody struct {
+	io.ReadCloser
+	limit int64
+	read  int64
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.read > l.limit {
+		return 0, ResponseTooLargeError{Limit: l.limit, BytesRead: l.read}
+	}
+
+	// One byte more than the limit is read to find out if the body exceeds the limit.
+	if remaining := l.limit - l.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err := l.ReadCloser.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n - int(l.read-l.limit), ResponseTooLargeError{Limit: l.limit, BytesRead: l.read}
+	}
+
+	return n, err
+}
+
 // getDecoderByContentType returns the decoder according to the content type.
 // If contentType is unknown, then nil is returned.
 func getDecoderByContentType(contentType string) Decoder {
@@ -308,36 +480,49 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 		}
 	}
 
+	if r, ok := req.body.(io.Reader); ok {
+		// The body is sent as it is, without encoding and compression.
+		return func() (io.Reader, error) {
+			return req.readerBody(r)
+		}
+	}
+
 	if !stream {
 		return func() (io.Reader, error) {
 			b := bytes.NewBuffer([]byte{})
-			compressedWriter, err := newCompression(j.config.Compression).ApplyRequestCompression(req, b)
+			if err := decoder.Encode(b, req.body); err != nil {
+				log.Errorf(err, "error encoding body - OBJ: %v", req.body)
+				return nil, err
+			}
+
+			if c := j.config.Compression; c == nil || b.Len() < c.RequestCompressionMinSize {
+				// Small bodies are not compressed.
+				return b, nil
+			}
+
+			compressed := bytes.NewBuffer(make([]byte, 0, b.Len()/2))
+			compressedWriter, err := newCompression(j.config.Compression).ApplyRequestCompression(req, compressed)
 			if err != nil {
 				log.Errorf(err, "error applying compression")
 				return nil, err
 			}
 
-			if compressedWriter != nil {
-				defer func(compressedWriter io.WriteCloser) {
-					errCompression := compressedWriter.Close()
-					if errCompression != nil {
-						log.Error(errCompression, "error closing compressed writer")
-						if err == nil {
-							err = errCompression
-						}
-					}
-				}(compressedWriter)
+			if compressedWriter == nil {
+				return b, nil
+			}
 
-				err = decoder.Encode(compressedWriter, req.body)
-			} else {
-				err = decoder.Encode(b, req.body)
+			if _, err := io.Copy(compressedWriter, b); err != nil {
+				compressedWriter.Close()
+				log.Errorf(err, "error compressing body")
+				return nil, err
 			}
 
-			if err != nil {
-				log.Errorf(err, "error encoding body - OBJ: %v", req.body)
+			if err := compressedWriter.Close(); err != nil {
+				log.Error(err, "error closing compressed writer")
 				return nil, err
 			}
-			return b, err
+
+			return compressed, nil
 		}
 	} else {
 		return func() (io.Reader, error) {
@@ -21,9 +21,14 @@
 package connection
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,7 +37,7 @@ import (
 func Test_httpConnection_Decoder(t *testing.T) {
 	tests := map[string]struct {
 		contentType string
-		conn        httpConnection
+		conn        *httpConnection
 		wantDecoder Decoder
 	}{
 		"JSON response decoder": {
@@ -48,19 +53,19 @@ func Test_httpConnection_Decoder(t *testing.T) {
 			wantDecoder: getBytesDecoder(),
 		},
 		"JSON HTTP connection decoder": {
-			conn: httpConnection{
+			conn: &httpConnection{
 				contentType: ApplicationJSON,
 			},
 			wantDecoder: getJsonDecoder(),
 		},
 		"VPack HTTP connection decoder": {
-			conn: httpConnection{
+			conn: &httpConnection{
 				contentType: ApplicationVPack,
 			},
 			wantDecoder: getVPackDecoder(),
 		},
 		"Bytes HTTP connection decoder": {
-			conn: httpConnection{
+			conn: &httpConnection{
 				contentType: PlainText,
 			},
 			wantDecoder: getBytesDecoder(),
@@ -72,7 +77,12 @@ func Test_httpConnection_Decoder(t *testing.T) {
 
 	for testName, test := range tests {
 		t.Run(testName, func(t *testing.T) {
-			decoder := test.conn.Decoder(test.contentType)
+			conn := test.conn
+			if conn == nil {
+				conn = &httpConnection{}
+			}
+
+			decoder := conn.Decoder(test.contentType)
 
 			require.NotNil(t, decoder)
 			assert.Equal(t, test.wantDecoder, decoder)
@@ -116,3 +126,210 @@ func Test_httpConnection_NewRequestWithEndpoint(t *testing.T) {
 		require.True(t, strings.HasPrefix(req.URL(), ep))
 	}
 }
+
+func Test_httpConnection_bodyReadFunc_Reader(t *testing.T) {
+	c := httpConnection{}
+
+	read := func(t *testing.T, req *httpRequest) string {
+		// The factory is created for every attempt, so the state must be kept by the request.
+		body, err := c.bodyReadFunc(getJsonDecoder(), req, false)()
+		require.NoError(t, err)
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("rewindable readers", func(t *testing.T) {
+		for name, body := range map[string]io.Reader{
+			"io.Seeker":    strings.NewReader("raw data"),
+			"bytes.Buffer": bytes.NewBufferString("raw data"),
+		} {
+			req := &httpRequest{}
+			require.NoError(t, req.SetBody(body))
+
+			assert.Equal(t, "raw data", read(t, req), name)
+			assert.True(t, canResend(req), name)
+			assert.Equal(t, "raw data", read(t, req), name)
+		}
+	})
+
+	t.Run("reader which can not be rewound", func(t *testing.T) {
+		req := &httpRequest{}
+		require.NoError(t, req.SetBody(io.MultiReader(strings.NewReader("raw data"))))
+		assert.True(t, canResend(req))
+
+		assert.Equal(t, "raw data", read(t, req))
+		assert.False(t, canResend(req))
+
+		_, err := c.bodyReadFunc(getJsonDecoder(), req, false)()
+		require.ErrorIs(t, err, ErrBodyNotRewindable)
+	})
+}
+
+func Test_httpConnection_MaxResponseBodySize(t *testing.T) {
+	body := `{"data":"` + strings.Repeat("x", 1000) + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	newConnection := func(limit int64) Connection {
+		return NewHttpConnection(HttpConfiguration{
+			Endpoint:            NewRoundRobinEndpoints([]string{server.URL}),
+			MaxResponseBodySize: limit,
+		})
+	}
+
+	t.Run("body exceeds the limit", func(t *testing.T) {
+		var output map[string]interface{}
+		_, err := CallGet(context.Background(), newConnection(100), "_api/version", &output)
+		require.Error(t, err)
+		require.True(t, IsResponseTooLarge(err))
+
+		var tooLarge ResponseTooLargeError
+		require.ErrorAs(t, err, &tooLarge)
+		assert.Equal(t, int64(100), tooLarge.Limit)
+		assert.Equal(t, int64(101), tooLarge.BytesRead)
+	})
+
+	t.Run("streamed body exceeds the limit", func(t *testing.T) {
+		_, reader, err := CallStream(context.Background(), newConnection(100), http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		defer reader.Close()
+
+		data, err := io.ReadAll(reader)
+		require.True(t, IsResponseTooLarge(err))
+		assert.Len(t, data, 100)
+	})
+
+	t.Run("body within the limit", func(t *testing.T) {
+		var output map[string]interface{}
+		_, err := CallGet(context.Background(), newConnection(int64(len(body))), "_api/version", &output)
+		require.NoError(t, err)
+		assert.Len(t, output["data"], 1000)
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		var output map[string]interface{}
+		_, err := CallGet(context.Background(), newConnection(0), "_api/version", &output)
+		require.NoError(t, err)
+	})
+}
+
+func Test_httpConnection_Close(t *testing.T) {
+	started := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		select {
+		case <-time.After(500 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"version":"3.12.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	newConnection := func(gracePeriod time.Duration) Connection {
+		return NewHttpConnection(HttpConfiguration{
+			Endpoint:         NewRoundRobinEndpoints([]string{server.URL}),
+			CloseGracePeriod: gracePeriod,
+		})
+	}
+
+	startSlowRequest := func(conn Connection) <-chan error {
+		result := make(chan error, 1)
+		go func() {
+			var output map[string]interface{}
+			_, err := CallGet(context.Background(), conn, "_api/version", &output)
+			result <- err
+		}()
+		<-started
+		return result
+	}
+
+	t.Run("in-flight request completes within the grace period", func(t *testing.T) {
+		conn := newConnection(5 * time.Second)
+		result := startSlowRequest(conn)
+
+		require.NoError(t, conn.Close())
+		require.NoError(t, <-result)
+
+		var output map[string]interface{}
+		_, err := CallGet(context.Background(), conn, "_api/version", &output)
+		require.ErrorIs(t, err, ErrConnectionClosed)
+
+		require.NoError(t, conn.Close(), "closing the connection twice must not fail")
+	})
+
+	t.Run("in-flight request is canceled after the grace period", func(t *testing.T) {
+		conn := newConnection(50 * time.Millisecond)
+		result := startSlowRequest(conn)
+
+		start := time.Now()
+		require.NoError(t, conn.Close())
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		require.ErrorIs(t, <-result, context.Canceled)
+	})
+
+	t.Run("unclosed response body is not waited for", func(t *testing.T) {
+		streamServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("body"))
+		}))
+		t.Cleanup(streamServer.Close)
+
+		for _, gracePeriod := range []time.Duration{0, 50 * time.Millisecond} {
+			conn := NewHttpConnection(HttpConfiguration{
+				Endpoint:         NewRoundRobinEndpoints([]string{streamServer.URL}),
+				CloseGracePeriod: gracePeriod,
+			})
+
+			req, err := conn.NewRequest(http.MethodGet, "_api/version")
+			require.NoError(t, err)
+			_, body, err := conn.Stream(context.Background(), req)
+			require.NoError(t, err)
+
+			start := time.Now()
+			require.NoError(t, conn.Close())
+			assert.Less(t, time.Since(start), gracePeriod+500*time.Millisecond)
+			require.NoError(t, body.Close())
+		}
+	})
+
+	t.Run("close stops the endpoint discovery", func(t *testing.T) {
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint:              NewRoundRobinEndpoints([]string{server.URL}),
+			AutoDiscoverEndpoints: true,
+		})
+		discovery := conn.(*httpConnection).discovery
+		require.NotNil(t, discovery)
+
+		require.NoError(t, conn.Close())
+
+		discovery.refreshIfDue(conn)
+		require.False(t, discovery.refreshing, "stopped discovery must not refresh")
+	})
+}
+
+func Test_httpConnection_Stream_ResponseWithError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "deflate")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("not deflate"))
+	}))
+	t.Cleanup(server.Close)
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint: NewRoundRobinEndpoints([]string{server.URL}),
+	})
+
+	req, err := conn.NewRequest(http.MethodGet, "_api/version")
+	require.NoError(t, err)
+
+	resp, body, err := conn.Stream(context.Background(), req)
+	require.Error(t, err)
+	require.Nil(t, body)
+	require.NotNil(t, resp, "response must be returned together with the error")
+	require.Equal(t, http.StatusServiceUnavailable, resp.Code())
+}
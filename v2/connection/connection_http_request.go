@@ -21,10 +21,14 @@
 package connection
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
 var _ Request = &httpRequest{}
@@ -39,6 +43,13 @@ type httpRequest struct {
 	body interface{}
 
 	headers map[string]string
+
+	// bodySent is set when the io.Reader body has been read.
+	bodySent bool
+	// bodyStart is the offset at which the io.Seeker body starts.
+	bodyStart int64
+	// bodyData is the data of the *bytes.Buffer body, so it can be sent again.
+	bodyData []byte
 }
 
 func (j *httpRequest) GetHeader(key string) (string, bool) {
@@ -75,16 +86,82 @@ func (j *httpRequest) AddHeader(key, value string) {
 	j.headers[key] = value
 }
 
+// addMissingHeaders adds the given headers which are not set in the request yet.
+// The header names are compared case-insensitively.
+func (j *httpRequest) addMissingHeaders(headers map[string]string) {
+	for key, value := range headers {
+		found := false
+		for existing := range j.headers {
+			if strings.EqualFold(existing, key) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			j.AddHeader(key, value)
+		}
+	}
+}
+
 func (j *httpRequest) SetBody(i interface{}) error {
 	if i == nil {
 		return nil
 	}
 
 	j.body = i
+	j.bodySent = false
 
 	return nil
 }
 
+// readerBody returns the io.Reader body to be sent.
+// The *bytes.Buffer and io.Seeker bodies are rewound when they are sent again, e.g. by the retry wrappers.
+// The other readers can be sent only once, ErrBodyNotRewindable is returned afterwards.
+func (j *httpRequest) readerBody(r io.Reader) (io.Reader, error) {
+	first := !j.bodySent
+	j.bodySent = true
+
+	switch b := r.(type) {
+	case *bytes.Buffer:
+		if first {
+			j.bodyData = b.Bytes()
+		}
+		return bytes.NewReader(j.bodyData), nil
+	case io.Seeker:
+		if first {
+			start, err := b.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, err
+			}
+			j.bodyStart = start
+		} else if _, err := b.Seek(j.bodyStart, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return r, nil
+	default:
+		if !first {
+			return nil, errors.WithStack(ErrBodyNotRewindable)
+		}
+		return r, nil
+	}
+}
+
+// canResend returns false when the request has a body which has been sent already and which can not be rewound.
+func canResend(request Request) bool {
+	j, ok := request.(*httpRequest)
+	if !ok || !j.bodySent {
+		return true
+	}
+
+	switch j.body.(type) {
+	case *bytes.Buffer, io.Seeker:
+		return true
+	default:
+		return false
+	}
+}
+
 func (j *httpRequest) Method() string {
 	return j.method
 }
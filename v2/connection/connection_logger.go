@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RequestLogger is invoked with the summary of every request sent by the connection.
+// It is invoked when the response headers are received (or the request fails), so it must not block.
+type RequestLogger func(ctx context.Context, entry RequestLogEntry)
+
+// RequestLogEntry describes a single request.
+type RequestLogEntry struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the path and the query of the request, e.g. `/_db/test/_api/document/users/1?returnNew=true`.
+	URL string
+	// Endpoint is the endpoint to which the request is sent, if it has been selected before.
+	Endpoint string
+	// StatusCode is the status code of the response. It is 0 if the request failed.
+	StatusCode int
+	// Duration is the time elapsed until the response headers have been received.
+	Duration time.Duration
+	// Err is the error of the failed request.
+	Err error
+	// RequestBody is the encoded request body. It is set only if the bodies are logged.
+	// Bodies which are sent as they are (io.Reader) are not logged.
+	RequestBody []byte
+	// ResponseBody is the response body. It is set only if the bodies are logged.
+	ResponseBody []byte
+}
+
+// logStream performs the request and passes its summary to the logger.
+func (j *httpConnection) logStream(ctx context.Context, logger RequestLogger, req *httpRequest,
+	stream func(ctx context.Context, req *httpRequest) (*httpResponse, io.ReadCloser, error)) (*httpResponse, io.ReadCloser, error) {
+	entry := RequestLogEntry{
+		Method:   req.Method(),
+		URL:      "/" + strings.TrimPrefix(req.url.Path, "/"),
+		Endpoint: req.Endpoint(),
+	}
+	if req.url.RawQuery != "" {
+		entry.URL += "?" + req.url.RawQuery
+	}
+
+	if j.config.RequestLoggerBodies && req.body != nil {
+		if _, ok := req.body.(io.Reader); !ok {
+			var b bytes.Buffer
+			if err := j.Decoder(j.contentType).Encode(&b, req.body); err == nil {
+				entry.RequestBody = b.Bytes()
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, body, err := stream(ctx, req)
+	entry.Duration = time.Since(start)
+	entry.Err = err
+	if resp != nil {
+		entry.StatusCode = resp.Code()
+	}
+
+	if j.config.RequestLoggerBodies && err == nil && body != nil {
+		data, readErr := io.ReadAll(body)
+		body.Close()
+		if readErr != nil {
+			entry.Err = readErr
+			logger(contextOrBackground(ctx), entry)
+			return nil, nil, errors.WithStack(readErr)
+		}
+
+		entry.ResponseBody = data
+		body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	logger(contextOrBackground(ctx), entry)
+
+	return resp, body, err
+}
@@ -0,0 +1,143 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger collects the logged request entries.
+type recordingLogger struct {
+	lock    sync.Mutex
+	entries []RequestLogEntry
+}
+
+func (r *recordingLogger) log(_ context.Context, entry RequestLogEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+func Test_RequestLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(ContentType, ApplicationJSON)
+		switch r.URL.Path {
+		case "/_api/version":
+			w.Write([]byte(`{"version":"3.12.0"}`))
+		case "/_db/test/_api/document/users":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"_key":"1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":true,"code":404}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	newConnection := func(logger *recordingLogger, bodies bool) Connection {
+		return NewHttpConnection(HttpConfiguration{
+			Endpoint: NewRoundRobinEndpoints([]string{server.URL}),
+			ArangoDBConfig: ArangoDBConfiguration{
+				RequestLogger:       logger.log,
+				RequestLoggerBodies: bodies,
+			},
+		})
+	}
+
+	t.Run("requests are logged", func(t *testing.T) {
+		logger := &recordingLogger{}
+		conn := newConnection(logger, false)
+
+		var version struct {
+			Version string `json:"version"`
+		}
+		_, err := CallGet(context.Background(), conn, "_api/version", &version)
+		require.NoError(t, err)
+		require.Equal(t, "3.12.0", version.Version)
+
+		_, err = CallPost(context.Background(), conn, "_db/test/_api/document/users", nil,
+			map[string]string{"name": "John"}, WithQuery("returnNew", "true"))
+		require.NoError(t, err)
+
+		_, err = CallGet(context.Background(), conn, "_api/missing", nil)
+		require.NoError(t, err)
+
+		require.Len(t, logger.entries, 3)
+		expected := []struct {
+			method string
+			url    string
+			code   int
+		}{
+			{http.MethodGet, "/_api/version", http.StatusOK},
+			{http.MethodPost, "/_db/test/_api/document/users?returnNew=true", http.StatusCreated},
+			{http.MethodGet, "/_api/missing", http.StatusNotFound},
+		}
+		for i, e := range expected {
+			entry := logger.entries[i]
+			require.Equal(t, e.method, entry.Method)
+			require.Equal(t, e.url, entry.URL)
+			require.Equal(t, e.code, entry.StatusCode)
+			require.NoError(t, entry.Err)
+			require.Positive(t, entry.Duration)
+			require.Nil(t, entry.RequestBody)
+			require.Nil(t, entry.ResponseBody)
+		}
+	})
+
+	t.Run("bodies are logged on demand", func(t *testing.T) {
+		logger := &recordingLogger{}
+		conn := newConnection(logger, true)
+
+		var response struct {
+			Key string `json:"_key"`
+		}
+		_, err := CallPost(context.Background(), conn, "_db/test/_api/document/users", &response,
+			map[string]string{"name": "John"})
+		require.NoError(t, err)
+		require.Equal(t, "1", response.Key, "the response must be still readable")
+
+		require.Len(t, logger.entries, 1)
+		require.JSONEq(t, `{"name":"John"}`, string(logger.entries[0].RequestBody))
+		require.JSONEq(t, `{"_key":"1"}`, string(logger.entries[0].ResponseBody))
+	})
+
+	t.Run("failed requests are logged", func(t *testing.T) {
+		logger := &recordingLogger{}
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint:       NewRoundRobinEndpoints([]string{"http://127.0.0.1:1"}),
+			ArangoDBConfig: ArangoDBConfiguration{RequestLogger: logger.log},
+		})
+
+		_, err := CallGet(context.Background(), conn, "_api/version", nil)
+		require.Error(t, err)
+
+		require.Len(t, logger.entries, 1)
+		require.Zero(t, logger.entries[0].StatusCode)
+		require.Error(t, logger.entries[0].Err)
+	})
+}
@@ -134,3 +134,28 @@ func (c *connectionPool) connection() Connection {
 
 	return c.connections[id]
 }
+
+// Close closes all connections of the pool concurrently, so the grace period of each connection runs in parallel.
+func (c *connectionPool) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	errs := make([]error, len(c.connections))
+	var wg sync.WaitGroup
+	for i, conn := range c.connections {
+		wg.Add(1)
+		go func(i int, conn Connection) {
+			defer wg.Done()
+			errs[i] = conn.Close()
+		}(i, conn)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
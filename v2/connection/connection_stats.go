@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// RequestStats collects the statistics of the requests sent with a context, e.g. to attribute the bandwidth to tenants.
+// Use WithRequestStats to attach it to a context. The statistics are accumulated when more than one request,
+// e.g. a retry, is sent with the same context. It is safe for concurrent use.
+type RequestStats struct {
+	requests      atomic.Int64
+	bytesSent     atomic.Int64
+	bytesReceived atomic.Int64
+}
+
+// Requests returns the number of the requests sent.
+func (r *RequestStats) Requests() int64 {
+	return r.requests.Load()
+}
+
+// BytesSent returns the number of the bytes of the request bodies sent. The HTTP headers are not counted.
+func (r *RequestStats) BytesSent() int64 {
+	return r.bytesSent.Load()
+}
+
+// BytesReceived returns the number of the bytes of the response bodies received, before they are decompressed.
+// The HTTP headers are not counted. A body is counted as it is read, so the streamed body must be read to be counted.
+func (r *RequestStats) BytesReceived() int64 {
+	return r.bytesReceived.Load()
+}
+
+// countRequest records the request and counts the bytes of its body when it is sent.
+func (r *RequestStats) countRequest(req *http.Request) {
+	r.requests.Add(1)
+
+	// The body without data is left as it is, so the transport does not send it as a chunked one.
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = &countingBody{ReadCloser: req.Body, counter: &r.bytesSent}
+	}
+
+	if getBody := req.GetBody; getBody != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			body, err := getBody()
+			if err != nil || body == nil || body == http.NoBody {
+				return body, err
+			}
+			return &countingBody{ReadCloser: body, counter: &r.bytesSent}, nil
+		}
+	}
+}
+
+// countResponse counts the bytes of the response body when it is read.
+func (r *RequestStats) countResponse(resp *http.Response) {
+	if resp.Body != nil {
+		resp.Body = &countingBody{ReadCloser: resp.Body, counter: &r.bytesReceived}
+	}
+}
+
+// countingBody adds the number of the bytes read to the counter.
+type countingBody struct {
+	io.ReadCloser
+	counter *atomic.Int64
+}
+
+func (c *countingBody) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.counter.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequestStats(t *testing.T) {
+	responseBody := `{"data":"` + strings.Repeat("r", 2000) + `"}`
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = len(data)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(responseBody))
+	}))
+	t.Cleanup(server.Close)
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint: NewRoundRobinEndpoints([]string{server.URL}),
+	})
+
+	input := map[string]string{"data": strings.Repeat("s", 1000)}
+	encoded, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	t.Run("request with body", func(t *testing.T) {
+		stats := &RequestStats{}
+		ctx := WithRequestStats(context.Background(), stats)
+
+		var output map[string]interface{}
+		_, err := CallPost(ctx, conn, "_api/document/c", &output, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), stats.Requests())
+		assert.Equal(t, int64(received), stats.BytesSent())
+		assert.InDelta(t, len(encoded), stats.BytesSent(), 2, "the encoder may add a new line")
+		assert.Equal(t, int64(len(responseBody)), stats.BytesReceived())
+
+		_, err = CallPost(ctx, conn, "_api/document/c", &output, input)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), stats.Requests())
+		assert.Equal(t, int64(2*received), stats.BytesSent())
+		assert.Equal(t, int64(2*len(responseBody)), stats.BytesReceived())
+	})
+
+	t.Run("request without body", func(t *testing.T) {
+		stats := &RequestStats{}
+
+		var output map[string]interface{}
+		_, err := CallGet(WithRequestStats(context.Background(), stats), conn, "_api/version", &output)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), stats.Requests())
+		assert.Equal(t, int64(0), stats.BytesSent())
+		assert.Equal(t, int64(len(responseBody)), stats.BytesReceived())
+	})
+
+	t.Run("context without stats", func(t *testing.T) {
+		_, ok := HasRequestStats(context.Background())
+		assert.False(t, ok)
+	})
+}
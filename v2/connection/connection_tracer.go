@@ -0,0 +1,106 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// Attributes of the spans created by the driver.
+// The names follow the OpenTelemetry semantic conventions.
+const (
+	SpanAttributeDBSystem       = "db.system"
+	SpanAttributeDBName         = "db.name"
+	SpanAttributeDBOperation    = "db.operation"
+	SpanAttributeServerAddress  = "server.address"
+	SpanAttributeHTTPMethod     = "http.request.method"
+	SpanAttributeHTTPStatusCode = "http.response.status_code"
+	SpanAttributeURLPath        = "url.path"
+
+	// SpanDBSystem is the value of the SpanAttributeDBSystem attribute.
+	SpanDBSystem = "arangodb"
+)
+
+// Tracer creates spans around the requests, e.g. to plug in OpenTelemetry.
+// The span starts before the request is sent and ends when the response is received (or the request fails).
+type Tracer interface {
+	// StartSpan starts a new span with the given name and attributes.
+	// The returned context is used to send the request, so it can carry the span,
+	// e.g. it can be extended with WithRequestHeaders to propagate the trace to the server.
+	StartSpan(ctx context.Context, name string, attributes map[string]interface{}) (context.Context, Span)
+}
+
+// Span is a single traced request.
+type Span interface {
+	// SetAttribute sets the attribute of the span, e.g. the status code of the response.
+	SetAttribute(key string, value interface{})
+
+	// End finishes the span. The err is nil if the response has been received.
+	End(err error)
+}
+
+// traceStream performs the request within the span created by the tracer.
+func traceStream(ctx context.Context, tracer Tracer, req *httpRequest,
+	stream func(ctx context.Context) (*httpResponse, io.ReadCloser, error)) (*httpResponse, io.ReadCloser, error) {
+	urlPath := "/" + strings.TrimPrefix(req.url.Path, "/")
+	dbName, operation := spanOperation(req.Method(), urlPath)
+
+	attributes := map[string]interface{}{
+		SpanAttributeDBSystem:      SpanDBSystem,
+		SpanAttributeDBOperation:   operation,
+		SpanAttributeServerAddress: req.Endpoint(),
+		SpanAttributeHTTPMethod:    req.Method(),
+		SpanAttributeURLPath:       urlPath,
+	}
+	if dbName != "" {
+		attributes[SpanAttributeDBName] = dbName
+	}
+
+	ctx, span := tracer.StartSpan(contextOrBackground(ctx), operation, attributes)
+
+	resp, body, err := stream(ctx)
+	if resp != nil {
+		span.SetAttribute(SpanAttributeHTTPStatusCode, resp.Code())
+	}
+	span.End(err)
+
+	return resp, body, err
+}
+
+// spanOperation returns the name of the database and the operation for the given request.
+// The operation consists of the method and the first two segments of the API path, e.g. `POST /_api/document`,
+// so the identifiers of the resources (e.g. document keys) do not increase the cardinality of the span names.
+func spanOperation(method, urlPath string) (string, string) {
+	var dbName string
+	segments := strings.Split(strings.Trim(urlPath, "/"), "/")
+	if len(segments) >= 2 && segments[0] == "_db" {
+		dbName = segments[1]
+		segments = segments[2:]
+	}
+
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+
+	return dbName, method + " /" + strings.Join(segments, "/")
+}
@@ -0,0 +1,145 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubSpanKey struct{}
+
+type stubSpan struct {
+	name       string
+	attributes map[string]interface{}
+	ended      bool
+	err        error
+}
+
+func (s *stubSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *stubSpan) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+type stubTracer struct {
+	lock  sync.Mutex
+	spans []*stubSpan
+}
+
+func (s *stubTracer) StartSpan(ctx context.Context, name string, attributes map[string]interface{}) (context.Context, Span) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	span := &stubSpan{name: name, attributes: attributes}
+	s.spans = append(s.spans, span)
+
+	return context.WithValue(ctx, stubSpanKey{}, span), span
+}
+
+func Test_Tracer(t *testing.T) {
+	var spanInRequest bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		spanInRequest = r.Header.Get("X-Span") == "true"
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tracer := &stubTracer{}
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.URL}),
+		ArangoDBConfig: ArangoDBConfiguration{Tracer: tracer},
+	})
+
+	_, err := CallGet(context.Background(), conn, NewUrl("_db", "mydb", "_api", "document", "col", "key1"), nil)
+	require.NoError(t, err)
+
+	_, err = CallDelete(context.Background(), conn, NewUrl("_admin", "metrics", "v2"), nil)
+	require.NoError(t, err)
+
+	require.Len(t, tracer.spans, 2)
+
+	span := tracer.spans[0]
+	require.True(t, span.ended)
+	require.NoError(t, span.err)
+	require.Equal(t, "GET /_api/document", span.name)
+	require.Equal(t, map[string]interface{}{
+		SpanAttributeDBSystem:       SpanDBSystem,
+		SpanAttributeDBName:         "mydb",
+		SpanAttributeDBOperation:    "GET /_api/document",
+		SpanAttributeServerAddress:  server.URL,
+		SpanAttributeHTTPMethod:     http.MethodGet,
+		SpanAttributeURLPath:        "/_db/mydb/_api/document/col/key1",
+		SpanAttributeHTTPStatusCode: http.StatusOK,
+	}, span.attributes)
+
+	span = tracer.spans[1]
+	require.True(t, span.ended)
+	require.Equal(t, "DELETE /_admin/metrics", span.name)
+	require.NotContains(t, span.attributes, SpanAttributeDBName)
+	require.Equal(t, http.StatusNotFound, span.attributes[SpanAttributeHTTPStatusCode])
+
+	t.Run("span context is used for the request", func(t *testing.T) {
+		tracer := &headerTracer{}
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint:       NewRoundRobinEndpoints([]string{server.URL}),
+			ArangoDBConfig: ArangoDBConfiguration{Tracer: tracer},
+		})
+
+		_, err := CallGet(context.Background(), conn, "_api/version", nil)
+		require.NoError(t, err)
+		require.True(t, spanInRequest)
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		tracer := &stubTracer{}
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint:       NewRoundRobinEndpoints([]string{"http://127.0.0.1:1"}),
+			ArangoDBConfig: ArangoDBConfiguration{Tracer: tracer},
+		})
+
+		_, err := CallGet(context.Background(), conn, "_api/version", nil)
+		require.Error(t, err)
+		require.Len(t, tracer.spans, 1)
+		require.True(t, tracer.spans[0].ended)
+		require.Error(t, tracer.spans[0].err)
+		require.NotContains(t, tracer.spans[0].attributes, SpanAttributeHTTPStatusCode)
+	})
+}
+
+// headerTracer propagates the span to the server with a header.
+type headerTracer struct{}
+
+func (h *headerTracer) StartSpan(ctx context.Context, _ string, attributes map[string]interface{}) (context.Context, Span) {
+	return WithRequestHeaders(ctx, map[string]string{"X-Span": "true"}), &stubSpan{attributes: attributes}
+}
@@ -29,6 +29,9 @@ type ContextKey string
 const (
 	keyAsyncRequest ContextKey = "arangodb-async-request"
 	keyAsyncID      ContextKey = "arangodb-async-id"
+	keyEndpoint     ContextKey = "arangodb-endpoint"
+	keyHeaders      ContextKey = "arangodb-headers"
+	keyStats        ContextKey = "arangodb-stats"
 )
 
 // contextOrBackground returns the given context if it is not nil.
@@ -50,6 +53,26 @@ func WithAsyncID(parent context.Context, asyncID string) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyAsyncID, asyncID)
 }
 
+// WithEndpoint is used to configure a context that forces a request to be executed on a specific endpoint,
+// instead of the one chosen by the Endpoint manager of the connection, e.g. to send a sequence of requests
+// to the same coordinator.
+// If the endpoint is not one of the endpoints of the connection, an InvalidArgumentError is returned from requests.
+func WithEndpoint(parent context.Context, endpoint string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyEndpoint, FixupEndpointURLScheme(endpoint))
+}
+
+// WithRequestHeaders is used to configure a context to add custom headers (e.g. `X-Request-ID` for tracing) to the requests.
+// The headers set by the driver (e.g. `Authorization`) take precedence over the custom ones.
+func WithRequestHeaders(parent context.Context, headers map[string]string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyHeaders, headers)
+}
+
+// WithRequestStats is used to configure a context to record the statistics (e.g. bytes sent and received)
+// of the requests sent with it. The statistics can be read from the given stats after the call.
+func WithRequestStats(parent context.Context, stats *RequestStats) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyStats, stats)
+}
+
 //
 // READ METHODS
 //
@@ -79,3 +102,42 @@ func HasAsyncID(ctx context.Context) (string, bool) {
 
 	return "", false
 }
+
+// HasEndpoint returns the endpoint which the request must be executed on.
+func HasEndpoint(ctx context.Context) (string, bool) {
+	if ctx != nil {
+		if q := ctx.Value(keyEndpoint); q != nil {
+			if v, ok := q.(string); ok && v != "" {
+				return v, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// HasRequestHeaders returns the custom headers which must be added to the request.
+func HasRequestHeaders(ctx context.Context) (map[string]string, bool) {
+	if ctx != nil {
+		if q := ctx.Value(keyHeaders); q != nil {
+			if v, ok := q.(map[string]string); ok && len(v) > 0 {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// HasRequestStats returns the statistics which the requests must be recorded in.
+func HasRequestStats(ctx context.Context) (*RequestStats, bool) {
+	if ctx != nil {
+		if q := ctx.Value(keyStats); q != nil {
+			if v, ok := q.(*RequestStats); ok && v != nil {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -31,12 +31,25 @@ import (
 
 var _ json.Unmarshaler = &Array{}
 
+var _ DecoderBinder = &Array{}
+
 type Array struct {
 	decoder *json.Decoder
 
+	// elementDecoder decodes the elements, encoding/json is used when it is nil.
+	elementDecoder Decoder
+
 	lock sync.Mutex
 }
 
+// BindDecoder sets the decoder of the elements of the array.
+func (a *Array) BindDecoder(decoder Decoder) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.elementDecoder = decoder
+}
+
 func (a *Array) UnmarshalJSON(d []byte) error {
 	a.lock.Lock()
 	defer a.lock.Unlock()
@@ -59,6 +72,14 @@ func (a *Array) UnmarshalJSON(d []byte) error {
 	return nil
 }
 
+// ElementDecoder returns the decoder of the elements of the array, nil means encoding/json.
+func (a *Array) ElementDecoder() Decoder {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.elementDecoder
+}
+
 func (a *Array) Unmarshal(i interface{}) error {
 	a.lock.Lock()
 	defer a.lock.Unlock()
@@ -67,7 +88,16 @@ func (a *Array) Unmarshal(i interface{}) error {
 		return io.EOF
 	}
 
-	return a.decoder.Decode(i)
+	if a.elementDecoder == nil {
+		return a.decoder.Decode(i)
+	}
+
+	var element json.RawMessage
+	if err := a.decoder.Decode(&element); err != nil {
+		return err
+	}
+
+	return UnmarshalWith(a.elementDecoder, element, i)
 }
 
 func (a *Array) More() bool {
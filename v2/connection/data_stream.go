@@ -37,7 +37,8 @@ func dropBodyData(closer io.ReadCloser) error {
 	for {
 		_, err := closer.Read(b)
 		if err != nil {
-			if err == io.EOF {
+			if err == io.EOF || IsResponseTooLarge(err) {
+				// The too large body is not drained, it is closed.
 				return nil
 			}
 
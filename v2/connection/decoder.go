@@ -24,6 +24,7 @@
 package connection
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"io"
@@ -37,6 +38,24 @@ type Decoder interface {
 	Reencode(in, out interface{}) error
 }
 
+// DecoderBinder is implemented by the response values which decode their nested JSON values later,
+// e.g. the documents of an Array, into the objects provided by the caller.
+// A decoder with custom settings (see HttpConfiguration.NameMapper) binds itself to such values
+// before the response is decoded, so the nested values are decoded with the same settings.
+type DecoderBinder interface {
+	BindDecoder(decoder Decoder)
+}
+
+// UnmarshalWith decodes the JSON data into obj with the given decoder.
+// The encoding/json package is used when the decoder is nil.
+func UnmarshalWith(decoder Decoder, data []byte, obj interface{}) error {
+	if decoder == nil {
+		return json.Unmarshal(data, obj)
+	}
+
+	return decoder.Decode(bytes.NewReader(data), obj)
+}
+
 func getJsonDecoder() Decoder {
 	return jsonDecoderObj
 }
@@ -0,0 +1,471 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// NameMapper converts the name of a Go struct field into the JSON key.
+// It is applied only to the fields which do not have an explicit name in the `json` tag.
+type NameMapper func(fieldName string) string
+
+// SnakeCaseNameMapper converts the field names into snake_case, e.g. `UserName` into `user_name`.
+func SnakeCaseNameMapper(fieldName string) string {
+	return strings.Join(splitFieldName(fieldName), "_")
+}
+
+// LowerCamelCaseNameMapper converts the field names into lowerCamelCase, e.g. `UserName` into `userName`.
+func LowerCamelCaseNameMapper(fieldName string) string {
+	words := splitFieldName(fieldName)
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+	return strings.Join(words, "")
+}
+
+// splitFieldName splits the Go field name into lower case words, e.g. `HTTPServerID` into `http`, `server`, `id`.
+func splitFieldName(fieldName string) []string {
+	runes := []rune(fieldName)
+
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+		// A new word starts when the previous rune is lower case, or when an acronym is followed by a lower case rune.
+		if !unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			words = append(words, strings.ToLower(string(runes[start:i])))
+			start = i
+		}
+	}
+
+	return append(words, strings.ToLower(string(runes[start:])))
+}
+
+// newNameMapperJsonDecoder returns the JSON decoder which applies the given name mapper.
+func newNameMapperJsonDecoder(mapper NameMapper) Decoder {
+	return &nameMapperJsonDecoder{
+		mapper: mapper,
+	}
+}
+
+type nameMapperJsonDecoder struct {
+	mapper NameMapper
+
+	// fields contains the cached fields of the struct types.
+	fields sync.Map
+}
+
+func (n *nameMapperJsonDecoder) Decode(reader io.Reader, obj interface{}) error {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return err
+	}
+
+	return n.unmarshal(data, obj)
+}
+
+func (n *nameMapperJsonDecoder) Encode(writer io.Writer, obj interface{}) error {
+	return json.NewEncoder(writer).Encode(n.toJSONValue(reflect.ValueOf(obj)))
+}
+
+func (n *nameMapperJsonDecoder) Reencode(in, out interface{}) error {
+	d, err := json.Marshal(n.toJSONValue(reflect.ValueOf(in)))
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(d))
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return err
+	}
+
+	return n.unmarshal(data, out)
+}
+
+// unmarshal renames the mapped JSON keys back to the Go field names, so the standard decoding can be used.
+func (n *nameMapperJsonDecoder) unmarshal(data, obj interface{}) error {
+	if obj == nil {
+		return json.Unmarshal([]byte("null"), obj)
+	}
+
+	d, err := json.Marshal(n.fromJSONValue(data, reflect.TypeOf(obj)))
+	if err != nil {
+		return err
+	}
+
+	// The nested values which are decoded later, e.g. documents, must be decoded with the mapping too.
+	n.bindDecoder(reflect.ValueOf(obj), map[uintptr]bool{})
+
+	return json.Unmarshal(d, obj)
+}
+
+var decoderBinderType = reflect.TypeOf((*DecoderBinder)(nil)).Elem()
+
+// BindDecoder binds the decoder to the DecoderBinder values found in obj, if the decoder has custom settings.
+// The decoder binds itself only to the values which already exist in obj before the data is decoded.
+// The values allocated while decoding, e.g. the binders behind nil pointers, are never bound by the decoder,
+// and the values of the embedded unexported structs are not reached at all,
+// so they must be bound with this function after decoding.
+func BindDecoder(decoder Decoder, obj interface{}) {
+	if n, ok := decoder.(*nameMapperJsonDecoder); ok {
+		n.bindDecoder(reflect.ValueOf(obj), map[uintptr]bool{})
+	}
+}
+
+// bindDecoder binds the decoder to the DecoderBinder values found in the structs which the given value points to.
+// Maps and slices are not inspected, because the driver does not keep the binders there.
+func (n *nameMapperJsonDecoder) bindDecoder(v reflect.Value, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			n.bindDecoder(v.Elem(), visited)
+		}
+	case reflect.Ptr:
+		if v.IsNil() || visited[v.Pointer()] {
+			return
+		}
+		visited[v.Pointer()] = true
+
+		if v.Type().Implements(decoderBinderType) && v.CanInterface() {
+			v.Interface().(DecoderBinder).BindDecoder(n)
+			return
+		}
+		n.bindDecoder(v.Elem(), visited)
+	case reflect.Struct:
+		if v.CanAddr() {
+			if a := v.Addr(); a.Type().Implements(decoderBinderType) && a.CanInterface() {
+				a.Interface().(DecoderBinder).BindDecoder(n)
+				return
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				n.bindDecoder(v.Field(i), visited)
+			}
+		}
+	}
+}
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// mappedField describes the struct field and its JSON key.
+type mappedField struct {
+	index []int
+	// name is the key which is recognized by the standard JSON decoder.
+	name      string
+	key       string
+	omitEmpty bool
+	// quoted is set when the value is encoded as a JSON string (the `string` option of the tag).
+	quoted bool
+	typ    reflect.Type
+}
+
+// getFields returns the fields of the struct type, including the fields of the embedded structs.
+func (n *nameMapperJsonDecoder) getFields(t reflect.Type) []mappedField {
+	if f, ok := n.fields.Load(t); ok {
+		return f.([]mappedField)
+	}
+
+	var fields []mappedField
+	known := map[string]bool{}
+	n.collectFields(t, nil, known, &fields)
+
+	n.fields.Store(t, fields)
+	return fields
+}
+
+func (n *nameMapperJsonDecoder) collectFields(t reflect.Type, index []int, known map[string]bool, fields *[]mappedField) {
+	var embedded []reflect.StructField
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				// Fields of the embedded structs have lower priority than the fields of the outer struct.
+				embedded = append(embedded, f)
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		key := name
+		if key == "" {
+			name = f.Name
+			key = n.mapper(f.Name)
+		}
+		if known[key] {
+			continue
+		}
+		known[key] = true
+
+		*fields = append(*fields, mappedField{
+			index:     append(append([]int{}, index...), i),
+			name:      name,
+			key:       key,
+			omitEmpty: hasTagOption(opts, "omitempty"),
+			quoted:    hasTagOption(opts, "string") && isQuotableType(f.Type),
+			typ:       f.Type,
+		})
+	}
+
+	for _, f := range embedded {
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		n.collectFields(ft, append(append([]int{}, index...), f.Index...), known, fields)
+	}
+}
+
+// hasTagOption returns true if the comma-separated options of the `json` tag contain the given option.
+func hasTagOption(opts, option string) bool {
+	for opts != "" {
+		var o string
+		o, opts, _ = strings.Cut(opts, ",")
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isQuotableType returns true if the `string` tag option applies to the field type, like in encoding/json.
+func isQuotableType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// isEmptyValue reports whether the value is omitted by the `omitempty` tag option, like in encoding/json.
+// Structs, e.g. time.Time, are never empty.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Ptr:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
+
+// toJSONValue converts the value into a structure which is encoded with the mapped keys by the standard encoder.
+func (n *nameMapperJsonDecoder) toJSONValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	t := v.Type()
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return v.Interface()
+	}
+	if v.CanAddr() && (reflect.PtrTo(t).Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)) {
+		return v.Addr().Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return n.toJSONValue(v.Elem())
+	case reflect.Struct:
+		result := map[string]interface{}{}
+		for _, f := range n.getFields(t) {
+			fv, ok := fieldByIndex(v, f.index)
+			if !ok || (f.omitEmpty && isEmptyValue(fv)) {
+				continue
+			}
+			if f.quoted {
+				result[f.key] = n.toQuotedJSONValue(fv)
+				continue
+			}
+			result[f.key] = n.toJSONValue(fv)
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() || t.Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		result := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			result[iter.Key().String()] = n.toJSONValue(iter.Value())
+		}
+		return result
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 || (v.Kind() == reflect.Slice && v.IsNil()) {
+			return v.Interface()
+		}
+		result := make([]interface{}, v.Len())
+		for i := range result {
+			result[i] = n.toJSONValue(v.Index(i))
+		}
+		return result
+	default:
+		return v.Interface()
+	}
+}
+
+// toQuotedJSONValue converts the value of the field with the `string` tag option into a JSON string.
+// The values of the types with custom marshalers are not quoted, like in encoding/json.
+func (n *nameMapperJsonDecoder) toQuotedJSONValue(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	t := v.Type()
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) ||
+		(v.CanAddr() && (reflect.PtrTo(t).Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType))) {
+		return n.toJSONValue(v)
+	}
+
+	d, err := json.Marshal(v.Interface())
+	if err != nil {
+		// The encoder reports the error, e.g. for the NaN values.
+		return v.Interface()
+	}
+	return string(d)
+}
+
+// fieldByIndex returns the nested field, or false if one of the embedded pointers is nil.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// fromJSONValue renames the mapped keys of the decoded JSON data into the names of the fields of the given type.
+func (n *nameMapperJsonDecoder) fromJSONValue(data interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		if t.Implements(jsonUnmarshalerType) || t.Implements(textUnmarshalerType) {
+			return data
+		}
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return data
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			return data
+		}
+		fields := map[string]mappedField{}
+		for _, f := range n.getFields(t) {
+			fields[f.key] = f
+		}
+		result := make(map[string]interface{}, len(object))
+		for k, v := range object {
+			if f, ok := fields[k]; ok {
+				result[f.name] = n.fromJSONValue(v, f.typ)
+				continue
+			}
+			result[k] = v
+		}
+		return result
+	case reflect.Map:
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			return data
+		}
+		result := make(map[string]interface{}, len(object))
+		for k, v := range object {
+			result[k] = n.fromJSONValue(v, t.Elem())
+		}
+		return result
+	case reflect.Slice, reflect.Array:
+		array, ok := data.([]interface{})
+		if !ok {
+			return data
+		}
+		result := make([]interface{}, len(array))
+		for i, v := range array {
+			result[i] = n.fromJSONValue(v, t.Elem())
+		}
+		return result
+	default:
+		return data
+	}
+}
@@ -0,0 +1,244 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NameMappers(t *testing.T) {
+	tests := map[string]struct {
+		snake, camel string
+	}{
+		"Name":         {snake: "name", camel: "name"},
+		"UserName":     {snake: "user_name", camel: "userName"},
+		"ID":           {snake: "id", camel: "id"},
+		"UserID":       {snake: "user_id", camel: "userId"},
+		"HTTPServerID": {snake: "http_server_id", camel: "httpServerId"},
+		"Address2":     {snake: "address2", camel: "address2"},
+	}
+
+	for fieldName, test := range tests {
+		t.Run(fieldName, func(t *testing.T) {
+			assert.Equal(t, test.snake, SnakeCaseNameMapper(fieldName))
+			assert.Equal(t, test.camel, LowerCamelCaseNameMapper(fieldName))
+		})
+	}
+}
+
+type nameMapperMeta struct {
+	Key string `json:"_key,omitempty"`
+}
+
+type nameMapperAddress struct {
+	StreetName string
+}
+
+type nameMapperDoc struct {
+	nameMapperMeta `json:",inline"`
+
+	UserName  string
+	Tagged    string `json:"customTag"`
+	Skipped   string `json:"-"`
+	Optional  string `json:",omitempty"`
+	CreatedAt time.Time
+	Addresses []nameMapperAddress
+	Labels    map[string]nameMapperAddress
+	Raw       json.RawMessage
+}
+
+func Test_NameMapperJsonDecoder(t *testing.T) {
+	decoder := newNameMapperJsonDecoder(SnakeCaseNameMapper)
+
+	doc := nameMapperDoc{
+		nameMapperMeta: nameMapperMeta{Key: "key"},
+		UserName:       "John",
+		Tagged:         "tagged",
+		Skipped:        "skipped",
+		CreatedAt:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Addresses:      []nameMapperAddress{{StreetName: "Main"}},
+		Labels:         map[string]nameMapperAddress{"Home": {StreetName: "Second"}},
+		Raw:            json.RawMessage(`{"KeepAsIs":1}`),
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, decoder.Encode(&b, doc))
+
+	var encoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &encoded))
+	assert.Equal(t, map[string]interface{}{
+		"_key":       "key",
+		"user_name":  "John",
+		"customTag":  "tagged",
+		"created_at": "2024-01-02T03:04:05Z",
+		"addresses":  []interface{}{map[string]interface{}{"street_name": "Main"}},
+		"labels":     map[string]interface{}{"Home": map[string]interface{}{"street_name": "Second"}},
+		"raw":        map[string]interface{}{"KeepAsIs": float64(1)},
+	}, encoded)
+
+	t.Run("Decode", func(t *testing.T) {
+		var decoded nameMapperDoc
+		require.NoError(t, decoder.Decode(bytes.NewReader(b.Bytes()), &decoded))
+
+		expected := doc
+		expected.Skipped = ""
+		assert.Equal(t, expected, decoded)
+	})
+
+	t.Run("Reencode", func(t *testing.T) {
+		var decoded nameMapperDoc
+		require.NoError(t, decoder.Reencode(doc, &decoded))
+		assert.Equal(t, doc.UserName, decoded.UserName)
+		assert.Equal(t, doc.Addresses, decoded.Addresses)
+	})
+
+	t.Run("Decode into map", func(t *testing.T) {
+		var decoded map[string]interface{}
+		require.NoError(t, decoder.Decode(bytes.NewReader(b.Bytes()), &decoded))
+		assert.Equal(t, "John", decoded["user_name"])
+	})
+}
+
+type nameMapperStringer int
+
+func (s nameMapperStringer) MarshalText() ([]byte, error) {
+	return []byte("value"), nil
+}
+
+func Test_NameMapperJsonDecoder_MatchesEncodingJSON(t *testing.T) {
+	// The identity mapper keeps the field names, so the output must be the same as the output of encoding/json.
+	decoder := newNameMapperJsonDecoder(func(fieldName string) string { return fieldName })
+
+	zero := 0
+	one := 1
+	var nilMap map[string]int
+
+	tests := map[string]interface{}{
+		"omitempty with zero values": struct {
+			Bool      bool                   `json:",omitempty"`
+			Int       int                    `json:",omitempty"`
+			Uint      uint8                  `json:",omitempty"`
+			Float     float64                `json:",omitempty"`
+			String    string                 `json:",omitempty"`
+			Pointer   *int                   `json:",omitempty"`
+			Interface interface{}            `json:",omitempty"`
+			Slice     []int                  `json:",omitempty"`
+			Map       map[string]int         `json:",omitempty"`
+			Array     [0]int                 `json:",omitempty"`
+			Time      time.Time              `json:",omitempty"`
+			Struct    nameMapperAddress      `json:",omitempty"`
+			Nested    struct{ A, B string }  `json:",omitempty"`
+			Objects   []nameMapperAddress    `json:",omitempty"`
+			Values    map[string]interface{} `json:",omitempty"`
+		}{Map: nilMap, Values: map[string]interface{}{}},
+		"omitempty with non-zero values": struct {
+			Bool      bool        `json:",omitempty"`
+			Int       int         `json:",omitempty"`
+			String    string      `json:",omitempty"`
+			Pointer   *int        `json:",omitempty"`
+			Interface interface{} `json:",omitempty"`
+			Slice     []int       `json:",omitempty"`
+			Array     [2]int      `json:",omitempty"`
+			Time      time.Time   `json:",omitempty"`
+		}{
+			Bool:      true,
+			Int:       -1,
+			String:    "value",
+			Pointer:   &zero,
+			Interface: 0,
+			Slice:     []int{},
+			Array:     [2]int{},
+			Time:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		"string option": struct {
+			Bool        bool               `json:",string"`
+			Int         int                `json:",string"`
+			Uint        uint16             `json:",string"`
+			Float       float64            `json:",string"`
+			String      string             `json:",string"`
+			Pointer     *int               `json:",string"`
+			NilPointer  *int               `json:",string"`
+			Empty       int                `json:",string,omitempty"`
+			NotQuotable []int              `json:",string"`
+			Marshaler   nameMapperStringer `json:",string"`
+			Named       int                `json:"named,omitempty,string"`
+		}{
+			Bool:        true,
+			Int:         -12,
+			Uint:        7,
+			Float:       1.5,
+			String:      `say "hi"`,
+			Pointer:     &one,
+			NotQuotable: []int{1},
+			Marshaler:   3,
+			Named:       5,
+		},
+		"tag options without omitempty": struct {
+			NotOmitted string `json:",omitemptyx"`
+			Zero       int    `json:"zero"`
+		}{},
+	}
+
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			expected, err := json.Marshal(value)
+			require.NoError(t, err)
+
+			var b bytes.Buffer
+			require.NoError(t, decoder.Encode(&b, value))
+			assert.JSONEq(t, string(expected), b.String())
+		})
+	}
+
+	t.Run("Decode string option", func(t *testing.T) {
+		type doc struct {
+			UserCount int     `json:",string"`
+			Ratio     float64 `json:",string"`
+			Active    bool    `json:",string"`
+		}
+
+		decoder := newNameMapperJsonDecoder(SnakeCaseNameMapper)
+
+		var b bytes.Buffer
+		require.NoError(t, decoder.Encode(&b, doc{UserCount: 3, Ratio: 0.5, Active: true}))
+		assert.JSONEq(t, `{"user_count":"3","ratio":"0.5","active":"true"}`, b.String())
+
+		var decoded doc
+		require.NoError(t, decoder.Decode(bytes.NewReader(b.Bytes()), &decoded))
+		assert.Equal(t, doc{UserCount: 3, Ratio: 0.5, Active: true}, decoded)
+	})
+}
+
+func Test_httpConnection_DecoderWithNameMapper(t *testing.T) {
+	conn := NewHttpConnection(HttpConfiguration{
+		NameMapper: SnakeCaseNameMapper,
+	})
+
+	assert.IsType(t, &nameMapperJsonDecoder{}, conn.Decoder(ApplicationJSON))
+	assert.IsType(t, &nameMapperJsonDecoder{}, conn.Decoder(""))
+	assert.Equal(t, getVPackDecoder(), conn.Decoder(ApplicationVPack))
+}
@@ -0,0 +1,163 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+const (
+	defaultEndpointDiscoveryInterval = time.Minute
+	endpointDiscoveryTimeout         = 30 * time.Second
+)
+
+// ClusterEndpoints returns the endpoints of all coordinators of the cluster fetched with `/_api/cluster/endpoints`.
+// The endpoints are converted to URLs usable by the connection, e.g. `tcp://` into `http://`.
+func ClusterEndpoints(ctx context.Context, c Connection) ([]string, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Endpoints             []struct {
+			Endpoint string `json:"endpoint"`
+		} `json:"endpoints,omitempty"`
+	}
+
+	resp, err := CallGet(ctx, c, NewUrl("_api", "cluster", "endpoints"), &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		endpoints := make([]string, len(response.Endpoints))
+		for i, e := range response.Endpoints {
+			endpoints[i] = FixupEndpointURLScheme(e.Endpoint)
+		}
+		return endpoints, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// SynchronizeEndpoints fetches the endpoints of all coordinators and configures the connection
+// to use them in round-robin fashion.
+func SynchronizeEndpoints(ctx context.Context, c Connection) error {
+	endpoints, err := ClusterEndpoints(ctx, c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(endpoints) == 0 {
+		return errors.WithStack(shared.InvalidArgumentError{Message: "no cluster endpoints found"})
+	}
+
+	return c.SetEndpoint(NewRoundRobinEndpoints(endpoints))
+}
+
+// newEndpointDiscovery returns the discovery which synchronizes the endpoints of the connection
+// with the coordinators of the cluster at most once per the given interval.
+func newEndpointDiscovery(interval time.Duration) *endpointDiscovery {
+	if interval <= 0 {
+		interval = defaultEndpointDiscoveryInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &endpointDiscovery{
+		interval: interval,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+type endpointDiscovery struct {
+	interval time.Duration
+
+	lock        sync.Mutex
+	lastRefresh time.Time
+	refreshing  bool
+	stopped     bool
+
+	// ctx is canceled when the discovery is stopped.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	running sync.WaitGroup
+}
+
+// refreshIfDue refreshes the endpoints in the background when the discovery interval has passed since the last refresh.
+func (d *endpointDiscovery) refreshIfDue(c Connection) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.stopped || d.refreshing || time.Since(d.lastRefresh) < d.interval {
+		return
+	}
+
+	d.refreshing = true
+	d.running.Add(1)
+
+	go func() {
+		defer d.running.Done()
+
+		err := d.refresh(c)
+
+		d.lock.Lock()
+		defer d.lock.Unlock()
+
+		d.refreshing = false
+		d.lastRefresh = time.Now()
+
+		if err != nil && !d.stopped {
+			log.Errorf(err, "endpoint discovery failed")
+		}
+	}()
+}
+
+// stop cancels the running refresh, waits for it to finish and disables the next refreshes.
+func (d *endpointDiscovery) stop() {
+	d.lock.Lock()
+	d.stopped = true
+	d.lock.Unlock()
+
+	d.cancel()
+	d.running.Wait()
+}
+
+// refresh synchronizes the endpoints of the connection with the coordinators of the cluster.
+// The endpoints are kept when the server is not a coordinator or no coordinator is found.
+func (d *endpointDiscovery) refresh(c Connection) error {
+	ctx, cancel := context.WithTimeout(d.ctx, endpointDiscoveryTimeout)
+	defer cancel()
+
+	err := SynchronizeEndpoints(ctx, c)
+	if ok, _ := shared.IsArangoError(err); ok || shared.IsInvalidArgument(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_EndpointDiscovery(t *testing.T) {
+	coordinator := newNamedServer(t, "b")
+
+	var bootstrapURL string
+	bootstrap := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/_api/cluster/endpoints" {
+			endpoints := []string{
+				strings.Replace(bootstrapURL, "http://", "tcp://", 1),
+				strings.Replace(coordinator.URL, "http://", "tcp://", 1),
+			}
+			w.Write([]byte(`{"error":false,"code":200,"endpoints":[{"endpoint":"` + strings.Join(endpoints, `"},{"endpoint":"`) + `"}]}`))
+			return
+		}
+		w.Write([]byte(`{"server":"a"}`))
+	}))
+	t.Cleanup(bootstrap.Close)
+	bootstrapURL = bootstrap.URL
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint:                  NewRoundRobinEndpoints([]string{bootstrap.URL}),
+		AutoDiscoverEndpoints:     true,
+		EndpointDiscoveryInterval: time.Hour,
+	})
+
+	serverName := func() string {
+		var response struct {
+			Server string `json:"server"`
+		}
+		_, err := CallGet(context.Background(), conn, "_api/version", &response)
+		require.NoError(t, err)
+		return response.Server
+	}
+
+	require.Equal(t, "a", serverName())
+
+	require.Eventually(t, func() bool {
+		return len(conn.GetEndpoint().List()) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.ElementsMatch(t, []string{bootstrap.URL, coordinator.URL}, conn.GetEndpoint().List())
+
+	names := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		names[serverName()] = true
+	}
+	require.Len(t, names, 2)
+}
+
+func Test_EndpointDiscovery_NotCluster(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/_api/cluster/endpoints" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":true,"code":403,"errorNum":1496,"errorMessage":"this API is only available on coordinators"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	discovery := newEndpointDiscovery(0)
+	conn := NewHttpConnection(HttpConfiguration{Endpoint: NewRoundRobinEndpoints([]string{server.URL})})
+
+	require.NoError(t, discovery.refresh(conn))
+	require.Equal(t, []string{server.URL}, conn.GetEndpoint().List())
+}
+
+func Test_SynchronizeEndpoints(t *testing.T) {
+	var endpoints atomic.Value
+	endpoints.Store(`[]`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":false,"code":200,"endpoints":` + endpoints.Load().(string) + `}`))
+	}))
+	t.Cleanup(server.Close)
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint:            NewRoundRobinEndpoints([]string{server.URL}),
+		EndpointHealthCheck: &EndpointHealthCheckConfiguration{Interval: time.Hour},
+	})
+	t.Cleanup(func() { conn.Close() })
+
+	t.Run("no endpoints", func(t *testing.T) {
+		err := SynchronizeEndpoints(context.Background(), conn)
+		require.True(t, shared.IsInvalidArgument(err))
+		require.Equal(t, []string{server.URL}, conn.GetEndpoint().List())
+	})
+
+	t.Run("endpoints are replaced", func(t *testing.T) {
+		endpoints.Store(`[{"endpoint":"tcp://127.0.0.1:8529"},{"endpoint":"ssl://127.0.0.1:8530"}]`)
+
+		require.NoError(t, SynchronizeEndpoints(context.Background(), conn))
+
+		_, ok := conn.GetEndpoint().(HealthCheckEndpoint)
+		require.True(t, ok, "synchronized endpoints must be health-checked")
+		require.Equal(t, []string{"http://127.0.0.1:8529", "https://127.0.0.1:8530"}, conn.GetEndpoint().List())
+	})
+}
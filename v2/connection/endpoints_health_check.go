@@ -0,0 +1,238 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHealthCheckInterval = 5 * time.Second
+	defaultHealthCheckTimeout  = 2 * time.Second
+)
+
+// EndpointHealthCheckConfiguration configures the background health checking of the endpoints.
+type EndpointHealthCheckConfiguration struct {
+	// Interval between two probes of the endpoints.
+	// Default: 5s
+	Interval time.Duration
+
+	// Timeout of a single probe.
+	// Default: 2s
+	Timeout time.Duration
+}
+
+func (h EndpointHealthCheckConfiguration) getInterval() time.Duration {
+	if h.Interval <= 0 {
+		return defaultHealthCheckInterval
+	}
+
+	return h.Interval
+}
+
+func (h EndpointHealthCheckConfiguration) getTimeout() time.Duration {
+	if h.Timeout <= 0 {
+		return defaultHealthCheckTimeout
+	}
+
+	return h.Timeout
+}
+
+// HealthCheckEndpoint is the Endpoint manager which periodically probes `/_api/version` on every endpoint.
+// Failing endpoints are removed from the rotation until they respond again.
+type HealthCheckEndpoint interface {
+	Endpoint
+
+	// Healthy returns endpoints which responded to the last probe.
+	Healthy() []string
+
+	// Stop stops the background health checking.
+	Stop()
+}
+
+// NewHealthCheckEndpoints returns Endpoint manager which chooses endpoints with the given Endpoint manager,
+// but skips the endpoints which failed the last health check.
+// When all endpoints are unhealthy, the given Endpoint manager decides alone.
+// The health checking runs in the background until Stop is called.
+func NewHealthCheckEndpoints(e Endpoint, transport http.RoundTripper, config EndpointHealthCheckConfiguration) HealthCheckEndpoint {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h := &healthCheckEndpoints{
+		endpoint:  e,
+		client:    &http.Client{Transport: transport},
+		config:    config,
+		unhealthy: map[string]bool{},
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+
+	go h.run(ctx)
+
+	return h
+}
+
+type healthCheckEndpoints struct {
+	endpoint Endpoint
+	client   *http.Client
+	config   EndpointHealthCheckConfiguration
+
+	lock      sync.RWMutex
+	unhealthy map[string]bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (h *healthCheckEndpoints) List() []string {
+	return h.endpoint.List()
+}
+
+func (h *healthCheckEndpoints) Get(providedEp, requestMethod, requestPath string) (string, error) {
+	if providedEp != "" {
+		return h.endpoint.Get(providedEp, requestMethod, requestPath)
+	}
+
+	endpoints := h.endpoint.List()
+
+	first, err := h.endpoint.Get("", requestMethod, requestPath)
+	if err != nil {
+		return "", err
+	}
+
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	if !h.unhealthy[first] {
+		return first, nil
+	}
+
+	for i := 1; i < len(endpoints); i++ {
+		ep, err := h.endpoint.Get("", requestMethod, requestPath)
+		if err != nil {
+			return "", err
+		}
+
+		if !h.unhealthy[ep] {
+			return ep, nil
+		}
+	}
+
+	// The Endpoint manager may always return the same endpoint for the request, e.g. maglev hashing.
+	for _, ep := range endpoints {
+		if !h.unhealthy[ep] {
+			return ep, nil
+		}
+	}
+
+	return first, nil
+}
+
+func (h *healthCheckEndpoints) Healthy() []string {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	var healthy []string
+	for _, ep := range h.endpoint.List() {
+		if !h.unhealthy[ep] {
+			healthy = append(healthy, ep)
+		}
+	}
+
+	return healthy
+}
+
+func (h *healthCheckEndpoints) Stop() {
+	h.cancel()
+	<-h.done
+}
+
+func (h *healthCheckEndpoints) run(ctx context.Context) {
+	defer close(h.done)
+
+	ticker := time.NewTicker(h.config.getInterval())
+	defer ticker.Stop()
+
+	for {
+		h.probeAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probeAll probes all endpoints concurrently and updates the set of unhealthy endpoints.
+func (h *healthCheckEndpoints) probeAll(ctx context.Context) {
+	endpoints := h.endpoint.List()
+	results := make([]bool, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, ep := range endpoints {
+		wg.Add(1)
+		go func(i int, ep string) {
+			defer wg.Done()
+			results[i] = h.probe(ctx, ep)
+		}(i, ep)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	unhealthy := map[string]bool{}
+	for i, ep := range endpoints {
+		if !results[i] {
+			unhealthy[ep] = true
+		}
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.unhealthy = unhealthy
+}
+
+// probe returns true when the endpoint responds to the version request.
+// An endpoint which rejects the unauthenticated request is still considered healthy.
+func (h *healthCheckEndpoints) probe(ctx context.Context, endpoint string) bool {
+	ctx, cancel := context.WithTimeout(ctx, h.config.getTimeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(FixupEndpointURLScheme(endpoint), "/")+"/_api/version", nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer dropBodyData(resp.Body)
+
+	return resp.StatusCode < http.StatusInternalServerError
+}
@@ -0,0 +1,161 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HealthCheckEndpoints(t *testing.T) {
+	healthy := newNamedServer(t, "healthy")
+
+	var failing atomic.Bool
+	failing.Store(true)
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"server":"flaky"}`))
+	}))
+	t.Cleanup(flaky.Close)
+
+	interval := 50 * time.Millisecond
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint: NewRoundRobinEndpoints([]string{flaky.URL, healthy.URL}),
+		EndpointHealthCheck: &EndpointHealthCheckConfiguration{
+			Interval: interval,
+			Timeout:  time.Second,
+		},
+	})
+
+	endpoint, ok := conn.GetEndpoint().(HealthCheckEndpoint)
+	require.True(t, ok)
+	t.Cleanup(endpoint.Stop)
+
+	serverName := func() string {
+		var response struct {
+			Server string `json:"server"`
+		}
+		_, err := CallGet(context.Background(), conn, "_api/version", &response)
+		require.NoError(t, err)
+		return response.Server
+	}
+
+	t.Run("failing endpoint is removed", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return len(endpoint.Healthy()) == 1
+		}, time.Second, interval/5)
+		require.Equal(t, []string{healthy.URL}, endpoint.Healthy())
+
+		for i := 0; i < 10; i++ {
+			require.Equal(t, "healthy", serverName())
+		}
+	})
+
+	t.Run("pinned endpoint is not checked", func(t *testing.T) {
+		req, err := conn.NewRequestWithEndpoint(flaky.URL, http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		require.Equal(t, flaky.URL, req.Endpoint())
+	})
+
+	t.Run("recovered endpoint is added again", func(t *testing.T) {
+		failing.Store(false)
+		require.Eventually(t, func() bool {
+			return len(endpoint.Healthy()) == 2
+		}, time.Second, interval/5)
+
+		names := map[string]bool{}
+		for i := 0; i < 2; i++ {
+			names[serverName()] = true
+		}
+		require.Len(t, names, 2)
+	})
+}
+
+func Test_HealthCheckEndpoints_AllUnhealthy(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(failing.Close)
+
+	endpoint := NewHealthCheckEndpoints(NewRoundRobinEndpoints([]string{failing.URL}), http.DefaultTransport,
+		EndpointHealthCheckConfiguration{Interval: time.Hour})
+	defer endpoint.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(endpoint.Healthy()) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	ep, err := endpoint.Get("", http.MethodGet, "/_api/version")
+	require.NoError(t, err)
+	require.Equal(t, failing.URL, ep)
+}
+
+func Test_HealthCheckEndpoints_Lifecycle(t *testing.T) {
+	serverA := newNamedServer(t, "a")
+	serverB := newNamedServer(t, "b")
+
+	conn := NewHttpConnection(HttpConfiguration{
+		Endpoint:            NewRoundRobinEndpoints([]string{serverA.URL}),
+		EndpointHealthCheck: &EndpointHealthCheckConfiguration{Interval: time.Hour},
+	})
+
+	stopped := func(e Endpoint) bool {
+		select {
+		case <-e.(*healthCheckEndpoints).done:
+			return true
+		default:
+			return false
+		}
+	}
+
+	first, ok := conn.GetEndpoint().(HealthCheckEndpoint)
+	require.True(t, ok)
+	require.False(t, stopped(first))
+
+	t.Run("replaced endpoint is stopped", func(t *testing.T) {
+		require.NoError(t, conn.SetEndpoint(NewRoundRobinEndpoints([]string{serverB.URL})))
+
+		require.True(t, stopped(first))
+
+		second, ok := conn.GetEndpoint().(HealthCheckEndpoint)
+		require.True(t, ok, "new endpoint must be health-checked")
+		require.Equal(t, []string{serverB.URL}, second.List())
+		require.False(t, stopped(second))
+	})
+
+	t.Run("close stops the health checking", func(t *testing.T) {
+		second := conn.GetEndpoint()
+
+		require.NoError(t, conn.Close())
+		require.True(t, stopped(second))
+	})
+}
@@ -37,6 +37,13 @@ func NewError(code int, message string) error {
 	}
 }
 
+// ErrConnectionClosed is returned for the requests sent after the connection has been closed.
+var ErrConnectionClosed = errors.New("connection is closed")
+
+// ErrBodyNotRewindable is returned when a request with an io.Reader body, which can not be rewound, is sent again.
+// The *bytes.Buffer and io.Seeker bodies can be rewound.
+var ErrBodyNotRewindable = errors.New("request body can not be rewound to be sent again")
+
 type Error struct {
 	Code    int
 	Message string
@@ -70,3 +77,21 @@ func NewNotFoundError(msg string) error {
 func IsNotFoundError(err error) bool {
 	return IsCodeError(err, http.StatusNotFound)
 }
+
+// ResponseTooLargeError is returned when the response body exceeds the configured maximum size.
+type ResponseTooLargeError struct {
+	// Limit is the maximum size of the response body.
+	Limit int64
+	// BytesRead is the number of bytes read before the reading has been aborted.
+	BytesRead int64
+}
+
+func (e ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds the limit of %d bytes, %d bytes read", e.Limit, e.BytesRead)
+}
+
+// IsResponseTooLarge returns true if the given error is a ResponseTooLargeError.
+func IsResponseTooLarge(err error) bool {
+	var e ResponseTooLargeError
+	return errors.As(err, &e)
+}
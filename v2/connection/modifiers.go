@@ -53,11 +53,16 @@ func WithQuery(s, value string) RequestModifier {
 func applyArangoDBConfiguration(config ArangoDBConfiguration, ctx context.Context) RequestModifier {
 	return func(r Request) error {
 		// Set version header
-		val := fmt.Sprintf("go-driver-v2/%s", version.DriverVersion())
-		if len(config.DriverFlags) > 0 {
-			val = fmt.Sprintf("%s (%s)", val, strings.Join(config.DriverFlags, ","))
+		if !config.DisableDriverHeader {
+			val := config.DriverHeader
+			if val == "" {
+				val = fmt.Sprintf("go-driver-v2/%s", version.DriverVersion())
+				if len(config.DriverFlags) > 0 {
+					val = fmt.Sprintf("%s (%s)", val, strings.Join(config.DriverFlags, ","))
+				}
+			}
+			r.AddHeader("x-arango-driver", val)
 		}
-		r.AddHeader("x-arango-driver", val)
 
 		if config.ArangoQueueTimeoutEnabled {
 			if config.ArangoQueueTimeoutSec > 0 {
@@ -53,7 +53,7 @@ func (w *wrapAuthentication) Do(ctx context.Context, request Request, output int
 		return r, err
 	}
 
-	if r.Code() != http.StatusUnauthorized {
+	if r.Code() != http.StatusUnauthorized || !canResend(request) {
 		return r, err
 	}
 
@@ -73,7 +73,7 @@ func (w *wrapAuthentication) Stream(ctx context.Context, request Request) (Respo
 		return nil, nil, err
 	}
 
-	if r.Code() != http.StatusUnauthorized {
+	if r.Code() != http.StatusUnauthorized || !canResend(request) {
 		return r, body, err
 	}
 
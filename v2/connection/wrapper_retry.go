@@ -24,6 +24,9 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func RetryOn503(conn Connection, retries int) Connection {
@@ -59,7 +62,7 @@ func (w retryWrapper) Do(ctx context.Context, request Request, output interface{
 	for i := 0; i < w.retries; i++ {
 		r, err = w.Connection.Do(ctx, request, output, allowedStatusCodes...)
 
-		if w.wrapper(r, err) {
+		if w.wrapper(r, err) && canResend(request) {
 			continue
 		}
 
@@ -83,7 +86,7 @@ func (w retryWrapper) Stream(ctx context.Context, request Request) (Response, io
 	for i := 0; i < w.retries; i++ {
 		r, body, err = w.Connection.Stream(ctx, request)
 
-		if w.wrapper(r, err) {
+		if w.wrapper(r, err) && canResend(request) {
 			if body != nil {
 				// Discard the data.
 				body.Close()
@@ -105,3 +108,104 @@ func (w retryWrapper) Stream(ctx context.Context, request Request) (Response, io
 
 	return r, nil, err
 }
+
+// RetryOn503WithRetryAfter returns a connection which retries requests answered with 503 (Service Unavailable),
+// e.g. by a coordinator which is starting up or is in maintenance.
+// Before each retry it waits for the delay given in the `Retry-After` response header, but not longer than maxDelay.
+// A response without the `Retry-After` header is retried immediately.
+// When the delay does not fit into the context deadline, the 503 response is returned without waiting.
+func RetryOn503WithRetryAfter(conn Connection, retries int, maxDelay time.Duration) Connection {
+	return &retryAfterWrapper{
+		Connection: conn,
+		retries:    retries,
+		maxDelay:   maxDelay,
+	}
+}
+
+type retryAfterWrapper struct {
+	Connection
+
+	retries  int
+	maxDelay time.Duration
+}
+
+func (w retryAfterWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	for i := 1; ; i++ {
+		r, err := w.Connection.Do(ctx, request, output, allowedStatusCodes...)
+		if i >= w.retries || r == nil || r.Code() != http.StatusServiceUnavailable || !canResend(request) {
+			return r, err
+		}
+
+		if !w.wait(ctx, r) {
+			return r, err
+		}
+	}
+}
+
+// Stream performs HTTP request.
+// It returns the response and body reader to read the data from there.
+// The caller is responsible to free the response body.
+func (w retryAfterWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	for i := 1; ; i++ {
+		r, body, err := w.Connection.Stream(ctx, request)
+		if i >= w.retries || err != nil || r.Code() != http.StatusServiceUnavailable || !canResend(request) {
+			return r, body, err
+		}
+
+		if !w.wait(ctx, r) {
+			return r, body, err
+		}
+
+		if body != nil {
+			// Discard the data.
+			body.Close()
+		}
+	}
+}
+
+// wait sleeps for the delay requested by the response.
+// It returns false when the request should not be retried.
+func (w retryAfterWrapper) wait(ctx context.Context, r Response) bool {
+	delay := parseRetryAfter(r.Header("Retry-After"), time.Now())
+	if delay > w.maxDelay {
+		delay = w.maxDelay
+	}
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// parseRetryAfter returns the delay of the `Retry-After` header, given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return t.Sub(now)
+	}
+
+	return 0
+}
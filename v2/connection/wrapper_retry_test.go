@@ -0,0 +1,211 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newUnavailableServer starts a server which responds with 503 and the given Retry-After header
+// to the first `unavailable` requests.
+func newUnavailableServer(t *testing.T, unavailable int, retryAfter string) (*httptest.Server, func() []time.Time) {
+	var lock sync.Mutex
+	var requests []time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, time.Now())
+		n := len(requests)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if n <= unavailable {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":true,"code":503,"errorNum":503,"errorMessage":"service unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"version":"3.12.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []time.Time {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]time.Time(nil), requests...)
+	}
+}
+
+func Test_RetryOn503WithRetryAfter(t *testing.T) {
+	newConnection := func(server *httptest.Server, maxDelay time.Duration) Connection {
+		conn := NewHttpConnection(HttpConfiguration{
+			Endpoint: NewRoundRobinEndpoints([]string{server.URL}),
+		})
+		return RetryOn503WithRetryAfter(conn, 3, maxDelay)
+	}
+
+	version := func(ctx context.Context, conn Connection) (int, string, error) {
+		var response struct {
+			Version string `json:"version"`
+		}
+		resp, err := CallGet(ctx, conn, "_api/version", &response)
+		if resp == nil {
+			return 0, "", err
+		}
+		return resp.Code(), response.Version, err
+	}
+
+	t.Run("retry after the delay", func(t *testing.T) {
+		server, requests := newUnavailableServer(t, 1, "1")
+
+		code, v, err := version(context.Background(), newConnection(server, time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "3.12.0", v)
+
+		r := requests()
+		require.Len(t, r, 2)
+		require.GreaterOrEqual(t, r[1].Sub(r[0]), time.Second)
+	})
+
+	t.Run("delay is bounded by max delay", func(t *testing.T) {
+		server, requests := newUnavailableServer(t, 1, "3600")
+
+		start := time.Now()
+		code, _, err := version(context.Background(), newConnection(server, 10*time.Millisecond))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+		require.Less(t, time.Since(start), 10*time.Second)
+		require.Len(t, requests(), 2)
+	})
+
+	t.Run("delay exceeding the deadline is not awaited", func(t *testing.T) {
+		server, requests := newUnavailableServer(t, 1, "3600")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		code, _, err := version(ctx, newConnection(server, time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Len(t, requests(), 1)
+	})
+
+	t.Run("retries are limited", func(t *testing.T) {
+		server, requests := newUnavailableServer(t, 10, "")
+
+		code, _, err := version(context.Background(), newConnection(server, time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Len(t, requests(), 3)
+	})
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, 2*time.Second, parseRetryAfter("2", now))
+	require.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	require.Zero(t, parseRetryAfter("", now))
+	require.Zero(t, parseRetryAfter("-1", now))
+	require.Zero(t, parseRetryAfter("soon", now))
+}
+
+func Test_RetryOn503_ReaderBody(t *testing.T) {
+	var lock sync.Mutex
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		lock.Lock()
+		bodies = append(bodies, string(data))
+		n := len(bodies)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":true,"code":503,"errorNum":503,"errorMessage":"service unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	const data = `{"name":"a"}` + "\n" + `{"name":"b"}`
+
+	testCases := map[string]struct {
+		body     func() io.Reader
+		code     int
+		expected []string
+	}{
+		"bytes.Buffer is rewound": {
+			body:     func() io.Reader { return bytes.NewBufferString(data) },
+			code:     http.StatusOK,
+			expected: []string{data, data},
+		},
+		"io.Seeker is rewound": {
+			body:     func() io.Reader { return strings.NewReader(data) },
+			code:     http.StatusOK,
+			expected: []string{data, data},
+		},
+		"other reader is not resent": {
+			body:     func() io.Reader { return io.MultiReader(strings.NewReader(data)) },
+			code:     http.StatusServiceUnavailable,
+			expected: []string{data},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for wrapperName, conn := range map[string]Connection{
+				"RetryOn503":               RetryOn503(NewHttpConnection(HttpConfiguration{Endpoint: NewRoundRobinEndpoints([]string{server.URL})}), 3),
+				"RetryOn503WithRetryAfter": RetryOn503WithRetryAfter(NewHttpConnection(HttpConfiguration{Endpoint: NewRoundRobinEndpoints([]string{server.URL})}), 3, time.Second),
+			} {
+				lock.Lock()
+				bodies = nil
+				lock.Unlock()
+
+				resp, _ := CallPost(context.Background(), conn, "_api/import", nil, tc.body())
+				require.NotNil(t, resp, wrapperName)
+				require.Equal(t, tc.code, resp.Code(), wrapperName)
+
+				lock.Lock()
+				require.Equal(t, tc.expected, bodies, wrapperName)
+				lock.Unlock()
+			}
+		})
+	}
+}
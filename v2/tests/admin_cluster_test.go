@@ -22,12 +22,16 @@ package tests
 
 import (
 	"context"
+	"crypto/tls"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/utils"
 )
 
 func Test_ClusterHealth(t *testing.T) {
@@ -64,6 +68,13 @@ func Test_ClusterHealth(t *testing.T) {
 			require.GreaterOrEqual(t, agents, 1, "Health did not return at least one agent")
 			require.GreaterOrEqual(t, dbServers, 1, "Health did not return at least one dbServer")
 			require.GreaterOrEqual(t, coordinators, 1, "Health did not return at least one coordinator")
+
+			leaderID, leader, ok := health.Leader()
+			require.True(t, ok, "Health did not return the agency leader")
+			require.NotEmpty(t, leaderID)
+			require.Equal(t, arangodb.ServerRoleAgent, leader.Role)
+			require.NotNil(t, leader.LastAckedTime)
+			require.NotNil(t, leader.LastAckedTime.Seconds, "the agents report the seconds since the acknowledgement")
 		})
 
 	})
@@ -196,6 +207,42 @@ func Test_ClusterDatabaseInventory(t *testing.T) {
 	})
 }
 
+func Test_ClusterShardDistribution(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			options := arangodb.CreateCollectionProperties{
+				NumberOfShards:    3,
+				ReplicationFactor: 2,
+			}
+			WithCollection(t, db, &options, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					health, err := client.Health(ctx)
+					require.NoError(t, err)
+
+					require.NoError(t, col.WaitForSync(ctx, time.Minute))
+
+					distribution, err := client.ShardDistribution(ctx, db.Name())
+					require.NoError(t, err)
+
+					colDistribution, ok := distribution[col.Name()]
+					require.True(t, ok, "collection %s is not reported", col.Name())
+					require.Len(t, colDistribution.Plan, 3)
+					require.Empty(t, colDistribution.OutOfSyncShards())
+
+					for shard, servers := range colDistribution.Plan {
+						require.Contains(t, health.Health, servers.Leader, "unexpected leader of shard %s", shard)
+						require.Len(t, servers.Followers, 1, "unexpected followers of shard %s", shard)
+						require.NotEqual(t, servers.Leader, servers.Followers[0])
+						require.Contains(t, health.Health, servers.Followers[0])
+					}
+				})
+			})
+		})
+	})
+}
+
 func Test_ClusterMoveShards(t *testing.T) {
 	requireClusterMode(t)
 
@@ -223,6 +270,7 @@ func Test_ClusterMoveShards(t *testing.T) {
 					require.NotEmpty(t, targetServerID, "No dbServer found")
 
 					movedShards := 0
+					var jobIDs []string
 					for _, colInv := range inv.Collections {
 						if colInv.Parameters.Name == col.Name() {
 							for shardID, dbServers := range colInv.Parameters.Shards {
@@ -231,12 +279,30 @@ func Test_ClusterMoveShards(t *testing.T) {
 									jobID, err := client.MoveShard(ctx, col, shardID, dbServers[0], targetServerID)
 									require.NoError(t, err, "MoveShard for shard %s in collection %s failed", shardID, col.Name())
 									require.NotEmpty(t, jobID, "MoveShard for shard %s in collection %s did not return a jobID", shardID, col.Name())
+									jobIDs = append(jobIDs, jobID)
 								}
 							}
 						}
 					}
 					require.Greater(t, movedShards, 0, "No shards moved")
 
+					t.Run("Check if move jobs are finished", func(t *testing.T) {
+						for _, jobID := range jobIDs {
+							NewTimeout(func() error {
+								job, err := client.QueryAgencyJob(ctx, jobID)
+								if err != nil {
+									return err
+								}
+								if !job.IsDone() {
+									return nil
+								}
+								require.Equal(t, arangodb.AgencyJobStatusFinished, job.Status, "Job %s failed: %s", jobID, job.Job.Reason)
+								require.Equal(t, "moveShard", job.Job.Type)
+								return Interrupt{}
+							}).TimeoutT(t, 2*time.Minute, time.Second)
+						}
+					})
+
 					t.Run("Check if shards are moved", func(t *testing.T) {
 						start := time.Now()
 						maxTestTime := 2 * time.Minute
@@ -285,6 +351,89 @@ func Test_ClusterMoveShards(t *testing.T) {
 	})
 }
 
+func Test_ClusterRebalanceShards(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+			skipBelowVersion(client, ctx, "3.10", t)
+
+			result, err := client.RebalanceShards(ctx, &arangodb.RebalanceOptions{
+				MaximumNumberOfMoves:     utils.NewType(10),
+				ExcludeSystemCollections: utils.NewType(true),
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(result.Moves), 10)
+			for _, move := range result.Moves {
+				require.NotEmpty(t, move.From)
+				require.NotEmpty(t, move.To)
+				require.NotEmpty(t, move.Shard)
+			}
+		})
+	})
+}
+
+func Test_ClusterEndpoints(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+			endpoints, err := client.ClusterEndpoints(ctx)
+			require.NoError(t, err)
+
+			health, err := client.Health(ctx)
+			require.NoError(t, err)
+
+			coordinators := 0
+			for _, s := range health.Health {
+				if s.Role == arangodb.ServerRoleCoordinator && s.Status == arangodb.ServerStatusGood {
+					coordinators++
+				}
+			}
+			require.Len(t, endpoints, coordinators)
+		})
+	})
+}
+
+func Test_ClusterAutoDiscoverEndpoints(t *testing.T) {
+	requireClusterMode(t)
+
+	conn := connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:              connection.NewRoundRobinEndpoints(getEndpointsFromEnv(t)[:1]),
+		AutoDiscoverEndpoints: true,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	})
+	withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+		conn = createAuthenticationFromEnv(tb, conn)
+	})
+	client := arangodb.NewClient(conn)
+
+	withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+		// The first request triggers the discovery.
+		_, err := client.Version(ctx)
+		require.NoError(t, err)
+
+		health, err := client.Health(ctx)
+		require.NoError(t, err)
+
+		coordinators := 0
+		for _, s := range health.Health {
+			if s.Role == arangodb.ServerRoleCoordinator && s.Status == arangodb.ServerStatusGood {
+				coordinators++
+			}
+		}
+
+		NewTimeout(func() error {
+			if len(conn.GetEndpoint().List()) == coordinators {
+				return Interrupt{}
+			}
+			return nil
+		}).TimeoutT(t, 30*time.Second, 250*time.Millisecond)
+	})
+}
+
 func Test_ClusterResignLeadership(t *testing.T) {
 	requireClusterMode(t)
 
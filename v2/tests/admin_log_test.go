@@ -22,6 +22,7 @@ package tests
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -66,6 +67,44 @@ func Test_LogLevels(t *testing.T) {
 	}, wrapOpts)
 }
 
+// Test_LogLevelsSingleTopic tests that the log level of a single topic can be changed without sending all the topics.
+func Test_LogLevelsSingleTopic(t *testing.T) {
+	// This test cannot run subtests parallel, because it changes admin settings.
+	wrapOpts := WrapOptions{
+		Parallel: utils.NewType(false),
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			logLevels, err := client.GetLogLevels(ctx, nil)
+			require.NoError(t, err)
+
+			const topic = "requests"
+			level, ok := logLevels[topic]
+			if !ok {
+				t.Skipf("topic %s is not available", topic)
+			}
+			defer func() {
+				require.NoError(t, client.SetLogLevels(ctx, arangodb.LogLevels{topic: level}, nil))
+			}()
+
+			err = client.SetLogLevels(ctx, arangodb.LogLevels{topic: "debug"}, nil)
+			require.NoError(t, err)
+
+			newLogLevels, err := client.GetLogLevels(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, "DEBUG", newLogLevels[topic])
+
+			// Other topics are not changed.
+			for otherTopic, otherLevel := range logLevels {
+				if otherTopic != topic {
+					require.Equal(t, otherLevel, newLogLevels[otherTopic], "topic %s", otherTopic)
+				}
+			}
+		})
+	}, wrapOpts)
+}
+
 // Test_LogLevelsForServers tests log levels for on specific server.
 func Test_LogLevelsForServers(t *testing.T) {
 	requireMode(t, testModeCluster, testModeResilientSingle)
@@ -137,6 +176,46 @@ func Test_LogLevelsForServers(t *testing.T) {
 	}, wrapOpts)
 }
 
+// Test_ReadLogEntries tests reading the log entries.
+func Test_ReadLogEntries(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			entries, err := client.ReadLogEntries(ctx, &arangodb.LogEntriesOptions{
+				Upto: "info",
+				Size: utils.NewType(10),
+				Sort: arangodb.LogEntriesSortDesc,
+			})
+			require.NoError(t, err)
+			if len(entries.Messages) == 0 {
+				t.Skip("test can not proceed without log entries")
+			}
+
+			require.LessOrEqual(t, len(entries.Messages), 10)
+			require.GreaterOrEqual(t, entries.Total, len(entries.Messages))
+			for i, entry := range entries.Messages {
+				require.NotEmpty(t, entry.Topic)
+				require.NotEmpty(t, entry.Level)
+				require.False(t, entry.Date.IsZero())
+				if i > 0 {
+					require.Less(t, entry.ID, entries.Messages[i-1].ID, "entries should be sorted descending")
+				}
+			}
+
+			t.Run("Level", func(t *testing.T) {
+				level := entries.Messages[0].Level
+				filtered, err := client.ReadLogEntries(ctx, &arangodb.LogEntriesOptions{
+					Level: strings.ToLower(level),
+				})
+				require.NoError(t, err)
+				require.NotEmpty(t, filtered.Messages)
+				for _, entry := range filtered.Messages {
+					require.Equal(t, level, entry.Level)
+				}
+			})
+		})
+	})
+}
+
 // Change log level from DEBUG to INFO or from something else to DEBUG.
 func changeLogLevel(l string) string {
 	if l != "DEBUG" {
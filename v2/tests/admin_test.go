@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func Test_ServerMode(t *testing.T) {
@@ -60,6 +61,40 @@ func Test_ServerMode(t *testing.T) {
 	}, wrapOpts)
 }
 
+func Test_ServerModeReadOnlyWrites(t *testing.T) {
+	// This test can not run sub-tests parallelly, because it changes admin settings.
+	wrapOpts := WrapOptions{
+		Parallel: utils.NewType(false),
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, time.Minute, func(ctx context.Context, _ testing.TB) {
+					meta, err := col.CreateDocument(ctx, UserDoc{Name: "John", Age: 13})
+					require.NoError(t, err)
+
+					require.NoError(t, client.SetServerMode(ctx, arangodb.ServerModeReadOnly))
+					defer func() {
+						require.NoError(t, client.SetServerMode(ctx, arangodb.ServerModeDefault))
+					}()
+
+					_, err = col.CreateDocument(ctx, UserDoc{Name: "Jane", Age: 14})
+					require.Error(t, err)
+					require.True(t, shared.IsReadOnly(err))
+
+					err = client.Ping(ctx)
+					require.True(t, arangodb.IsServerUnavailable(err))
+
+					var doc UserDoc
+					_, err = col.ReadDocument(ctx, meta.Key, &doc)
+					require.NoError(t, err, "reads are allowed in the read-only mode")
+				})
+			})
+		})
+	}, wrapOpts)
+}
+
 func Test_ServerID(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		withContextT(t, time.Minute, func(ctx context.Context, t testing.TB) {
@@ -89,3 +124,59 @@ func Test_Version(t *testing.T) {
 		})
 	})
 }
+
+func Test_Metrics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, time.Minute, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			const knownMetric = "arangodb_server_statistics_server_uptime_total"
+
+			metrics, err := client.Metrics(ctx, nil)
+			require.NoError(t, err)
+			require.Contains(t, metrics.Raw, knownMetric)
+
+			families, err := metrics.Families()
+			require.NoError(t, err)
+			require.Contains(t, families, knownMetric)
+			require.NotEmpty(t, families[knownMetric].Samples)
+
+			if getTestMode() != string(testModeCluster) {
+				return
+			}
+
+			health, err := client.Health(ctx)
+			require.NoError(t, err)
+
+			for id, server := range health.Health {
+				if server.Role != arangodb.ServerRoleDBServer {
+					continue
+				}
+
+				serverID := string(id)
+				metrics, err := client.Metrics(ctx, &serverID)
+				require.NoError(t, err)
+				require.Contains(t, metrics.Raw, knownMetric)
+				break
+			}
+		})
+	})
+}
+
+func Test_ExecuteAdminScript(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+			var result struct {
+				Sum int `json:"sum"`
+			}
+
+			err := client.ExecuteAdminScript(ctx, "return {sum: 1 + 2};", &result)
+			if arangodb.IsAdminScriptExecutionDisabled(err) {
+				// The endpoint is disabled by default.
+				t.Skipf("Execution of admin scripts is disabled: %v", err)
+			}
+			require.NoError(t, err)
+			require.Equal(t, 3, result.Sum)
+		})
+	})
+}
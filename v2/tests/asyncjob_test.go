@@ -150,6 +150,65 @@ func TestAsyncJobListPending(t *testing.T) {
 	}, asyncTestOpt)
 }
 
+func TestAsyncJobQueryResult(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				skipBelowVersion(client, ctx, "3.11.1", t)
+				skipResilientSingleMode(t)
+
+				ctxAsync := connection.WithAsync(context.Background())
+
+				aqlQuery := "FOR i IN 1..5 RETURN i"
+				_, err := db.Query(ctxAsync, aqlQuery, nil)
+				require.Error(t, err)
+
+				id, isAsyncId := connection.IsAsyncJobInProgress(err)
+				require.True(t, isAsyncId)
+				require.NotEmpty(t, id)
+
+				t.Run("poll job list until the query is done", func(t *testing.T) {
+					NewTimeout(func() error {
+						jobs, err := client.AsyncJobList(ctx, arangodb.JobDone, nil)
+						if err != nil {
+							return err
+						}
+						for _, j := range jobs {
+							if j == id {
+								return Interrupt{}
+							}
+						}
+						return nil
+					}).TimeoutT(t, 10*time.Second, 250*time.Millisecond)
+
+					status, err := client.AsyncJobStatus(ctx, id)
+					require.NoError(t, err)
+					require.Equal(t, arangodb.JobDone, status)
+				})
+
+				t.Run("read query result", func(t *testing.T) {
+					cursor, err := db.Query(connection.WithAsyncID(ctx, id), aqlQuery, nil)
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					var result []int
+					for cursor.HasMore() {
+						var i int
+						_, err := cursor.ReadDocument(ctx, &i)
+						require.NoError(t, err)
+						result = append(result, i)
+					}
+					require.Equal(t, []int{1, 2, 3, 4, 5}, result)
+
+					jobs, err := client.AsyncJobList(ctx, arangodb.JobDone, nil)
+					require.NoError(t, err)
+					require.NotContains(t, jobs, id)
+				})
+			})
+		})
+	}, asyncTestOpt)
+}
+
 func TestAsyncJobCancel(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		WithDatabase(t, client, nil, func(db arangodb.Database) {
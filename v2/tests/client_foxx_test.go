@@ -0,0 +1,95 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+// Test_FoxxService installs, lists, replaces and uninstalls a minimal Foxx service.
+func Test_FoxxService(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				mount := "/" + GenerateUUID("test-foxx")
+
+				info, err := client.InstallService(ctx, db.Name(), mount, newFoxxServiceZip(t, "1.0.0"), nil)
+				require.NoError(t, err)
+				require.Equal(t, mount, info.Mount)
+				require.Equal(t, "test-service", info.Name)
+				require.Equal(t, "1.0.0", info.Version)
+				require.False(t, info.Development)
+
+				services, err := client.ListServices(ctx, db.Name())
+				require.NoError(t, err)
+				require.Len(t, services, 1)
+				require.Equal(t, mount, services[0].Mount)
+				require.Equal(t, "1.0.0", services[0].Version)
+
+				info, err = client.ReplaceService(ctx, db.Name(), mount, newFoxxServiceZip(t, "1.1.0"),
+					&arangodb.FoxxReplaceOptions{Teardown: utils.NewType(true)})
+				require.NoError(t, err)
+				require.Equal(t, "1.1.0", info.Version)
+
+				require.NoError(t, client.UninstallService(ctx, db.Name(), mount))
+
+				services, err = client.ListServices(ctx, db.Name())
+				require.NoError(t, err)
+				require.Empty(t, services)
+
+				err = client.UninstallService(ctx, db.Name(), mount)
+				require.Error(t, err)
+			})
+		})
+	})
+}
+
+// newFoxxServiceZip returns the bundle of a minimal Foxx service in the zip format.
+func newFoxxServiceZip(t *testing.T, version string) *bytes.Buffer {
+	files := map[string]string{
+		"manifest.json": `{"name": "test-service", "version": "` + version + `", "main": "index.js", "engines": {"arangodb": "^3.0.0"}}`,
+		"index.js": `'use strict';
+const router = require('@arangodb/foxx/router')();
+module.context.use(router);
+router.get('/hello', function (req, res) { res.send({hello: 'world'}); });
+`,
+	}
+
+	buf := bytes.NewBuffer(nil)
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return buf
+}
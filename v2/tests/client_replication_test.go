@@ -0,0 +1,105 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_TailWAL(t *testing.T) {
+	// Coordinators do not provide the write-ahead log.
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, 2*time.Minute, func(ctx context.Context, tb testing.TB) {
+					tailCtx, cancel := context.WithCancel(ctx)
+					defer cancel()
+
+					events, errs := client.TailWAL(tailCtx, db.Name(), &arangodb.WALTailOptions{
+						PollInterval: 100 * time.Millisecond,
+					})
+
+					docs := make([]basicDocument, 0, 3)
+					for i := 0; i < 3; i++ {
+						docs = append(docs, basicDocument{Key: fmt.Sprintf("wal-%d", i)})
+					}
+					reader, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+					for {
+						_, err := reader.Read()
+						if shared.IsNoMoreDocuments(err) {
+							break
+						}
+						require.NoError(t, err)
+					}
+
+					_, err = col.DeleteDocument(ctx, "wal-0")
+					require.NoError(t, err)
+
+					inserted := map[string]bool{}
+					removed := map[string]bool{}
+					for len(inserted) < 3 || len(removed) < 1 {
+						select {
+						case event, ok := <-events:
+							require.True(t, ok, "events channel closed too early")
+							if event.Collection != col.Name() {
+								continue
+							}
+
+							switch event.Type {
+							case arangodb.WALOperationDocument:
+								inserted[event.Key] = true
+								require.NotEmpty(t, event.Tick)
+								require.NotEmpty(t, event.Data)
+							case arangodb.WALOperationRemove:
+								removed[event.Key] = true
+							}
+						case err := <-errs:
+							require.NoError(t, err)
+						case <-ctx.Done():
+							require.FailNow(t, "WAL events did not appear", "inserted: %v, removed: %v", inserted, removed)
+						}
+					}
+
+					require.Equal(t, map[string]bool{"wal-0": true, "wal-1": true, "wal-2": true}, inserted)
+					require.Equal(t, map[string]bool{"wal-0": true}, removed)
+
+					// Canceling the context closes both channels.
+					cancel()
+					for range events {
+					}
+					_, ok := <-errs
+					require.False(t, ok)
+				})
+			})
+		})
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/connection"
 )
 
 // Test_ServerRole tests a server role for all instances.
@@ -50,3 +51,49 @@ func Test_ServerRole(t *testing.T) {
 		})
 	})
 }
+
+// Test_ServerBootInfo checks that the boot information of the same server run is stable.
+func Test_ServerBootInfo(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			first, err := client.ServerBootInfo(ctx)
+			require.NoError(t, err)
+			require.NotZero(t, first.ProcessID)
+			require.NotZero(t, first.Uptime)
+			require.NotEmpty(t, first.Endpoint)
+
+			if getTestMode() == string(testModeCluster) {
+				require.NotEmpty(t, first.ServerID)
+			}
+
+			second, err := client.ServerBootInfo(ctx)
+			require.NoError(t, err)
+
+			if first.Endpoint == second.Endpoint {
+				require.False(t, second.RestartedSince(first))
+				require.GreaterOrEqual(t, second.Uptime, first.Uptime)
+			}
+		})
+	})
+}
+
+// Test_Ping checks that the reachable server responds to the ping, and the unreachable one does not.
+func Test_Ping(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			t.Run("reachable server", func(t *testing.T) {
+				require.NoError(t, client.Ping(ctx))
+			})
+
+			t.Run("unreachable server", func(t *testing.T) {
+				conn := connection.NewHttpConnection(connection.HttpConfiguration{
+					Endpoint: connection.NewRoundRobinEndpoints([]string{"http://127.0.0.1:1"}),
+				})
+
+				err := arangodb.NewClient(conn).Ping(ctx)
+				require.Error(t, err)
+				require.False(t, arangodb.IsServerUnavailable(err))
+			})
+		})
+	})
+}
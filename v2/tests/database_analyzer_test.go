@@ -22,6 +22,7 @@ package tests
 import (
 	"context"
 	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/arangodb/go-driver/v2/utils"
@@ -189,6 +190,39 @@ func Test_Analyzers(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:       "create-geopoint-with-fields",
+			MinVersion: newVersion("3.8"),
+			Definition: arangodb.AnalyzerDefinition{
+				Name: "my-geopoint-with-fields",
+				Type: arangodb.ArangoSearchAnalyzerTypeGeoPoint,
+				Properties: arangodb.ArangoSearchAnalyzerProperties{
+					Options: &arangodb.ArangoSearchAnalyzerGeoOptions{
+						MaxCells: utils.NewType(30),
+						MinLevel: utils.NewType(2),
+						MaxLevel: utils.NewType(20),
+					},
+					Latitude:  []string{"location", "lat"},
+					Longitude: []string{"location", "lng"},
+				},
+			},
+		},
+		{
+			Name:       "create-geojson-centroid",
+			MinVersion: newVersion("3.8"),
+			Definition: arangodb.AnalyzerDefinition{
+				Name: "my-geojson-centroid",
+				Type: arangodb.ArangoSearchAnalyzerTypeGeoJSON,
+				Properties: arangodb.ArangoSearchAnalyzerProperties{
+					Options: &arangodb.ArangoSearchAnalyzerGeoOptions{
+						MaxCells: utils.NewType(20),
+						MinLevel: utils.NewType(4),
+						MaxLevel: utils.NewType(23),
+					},
+					Type: arangodb.ArangoSearchAnalyzerGeoJSONTypeCentroid.New(),
+				},
+			},
+		},
 		{
 			Name:       "create-geojson",
 			MinVersion: newVersion("3.8"),
@@ -404,6 +438,60 @@ func Test_Analyzers(t *testing.T) {
 	})
 }
 
+// Test_AnalyzersML creates the ArangoML analyzers.
+// The path to a trained fastText model must be available on all servers and passed in TEST_ML_MODEL_LOCATION.
+func Test_AnalyzersML(t *testing.T) {
+	modelLocation := os.Getenv("TEST_ML_MODEL_LOCATION")
+	if modelLocation == "" {
+		t.Skipf("TEST_ML_MODEL_LOCATION must be set for ArangoML analyzer tests")
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				skipBelowVersion(client, ctx, "3.10", t)
+				skipNoEnterprise(client, ctx, t)
+
+				definitions := []arangodb.AnalyzerDefinition{
+					{
+						Name: "my-classification",
+						Type: arangodb.ArangoSearchAnalyzerTypeClassification,
+						Properties: arangodb.ArangoSearchAnalyzerProperties{
+							ModelLocation: modelLocation,
+							TopK:          utils.NewType[uint64](2),
+							Threshold:     utils.NewType(0.5),
+						},
+					},
+					{
+						Name: "my-nearest-neighbors",
+						Type: arangodb.ArangoSearchAnalyzerTypeNearestNeighbors,
+						Properties: arangodb.ArangoSearchAnalyzerProperties{
+							ModelLocation: modelLocation,
+							TopK:          utils.NewType[uint64](3),
+						},
+					},
+				}
+
+				for _, def := range definitions {
+					t.Run(def.Name, func(t *testing.T) {
+						_, a, err := db.EnsureAnalyzer(ctx, &def)
+						require.NoError(t, err)
+
+						gotA, err := db.Analyzer(ctx, a.Name())
+						require.NoError(t, err)
+						require.Equal(t, def.Type, gotA.Type())
+						require.Equal(t, def.Properties.ModelLocation, gotA.Definition().Properties.ModelLocation)
+						require.Equal(t, def.Properties.TopK, gotA.Definition().Properties.TopK)
+						if def.Properties.Threshold != nil {
+							require.Equal(t, def.Properties.Threshold, gotA.Definition().Properties.Threshold)
+						}
+					})
+				}
+			})
+		})
+	})
+}
+
 func Test_AnalyzerRemove(t *testing.T) {
 	def := arangodb.AnalyzerDefinition{
 		Name: "my-delimiter",
@@ -432,6 +520,67 @@ func Test_AnalyzerRemove(t *testing.T) {
 	})
 }
 
+func Test_DeleteAnalyzer(t *testing.T) {
+	def := arangodb.AnalyzerDefinition{
+		Name: "my-delete-delimiter",
+		Type: arangodb.ArangoSearchAnalyzerTypeDelimiter,
+		Properties: arangodb.ArangoSearchAnalyzerProperties{
+			Delimiter: ",",
+		},
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					_, _, err := db.EnsureAnalyzer(ctx, &def)
+					require.NoError(t, err)
+
+					analyzers, err := db.AnalyzersAll(ctx)
+					require.NoError(t, err)
+					found := false
+					for _, a := range analyzers {
+						if a.Name() == def.Name {
+							found = true
+							require.Equal(t, def.Type, a.Type())
+						}
+					}
+					require.True(t, found, "analyzer %s not listed", def.Name)
+					require.Len(t, analyzers, len(readAllAnalyzersT(ctx, t, db)))
+
+					_, err = db.CreateArangoSearchView(ctx, "test_delete_analyzer_view", &arangodb.ArangoSearchViewProperties{
+						Links: arangodb.ArangoSearchLinks{
+							col.Name(): arangodb.ArangoSearchElementProperties{
+								Analyzers: []string{def.Name},
+							},
+						},
+					})
+					require.NoError(t, err)
+
+					t.Run("delete analyzer in use", func(t *testing.T) {
+						err := db.DeleteAnalyzer(ctx, def.Name, false)
+						require.Error(t, err)
+						require.True(t, shared.IsConflict(err))
+					})
+
+					t.Run("force delete analyzer in use", func(t *testing.T) {
+						require.NoError(t, db.DeleteAnalyzer(ctx, def.Name, true))
+
+						_, err := db.Analyzer(ctx, def.Name)
+						require.True(t, shared.IsNotFound(err))
+					})
+
+					t.Run("delete non-existing analyzer", func(t *testing.T) {
+						err := db.DeleteAnalyzer(ctx, def.Name, true)
+						require.Error(t, err)
+						require.True(t, shared.IsNotFound(err))
+					})
+				})
+			})
+		})
+	})
+}
+
 func readAllAnalyzersT(ctx context.Context, t *testing.T, db arangodb.Database) []arangodb.Analyzer {
 	t.Helper()
 
@@ -0,0 +1,131 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_DatabaseCollectionDocAll(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					const docCount = 1000
+
+					err := arangodb.CreateDocuments(ctx, col, docCount, func(index int) any {
+						return UserDoc{Name: "John", Age: index}
+					})
+					require.NoError(t, err)
+
+					t.Run("read all documents", func(t *testing.T) {
+						reader, err := col.All(ctx, &arangodb.AllDocumentsOptions{BatchSize: 100})
+						require.NoError(t, err)
+						defer func() {
+							require.NoError(t, reader.Close())
+						}()
+
+						keys := map[string]struct{}{}
+						for {
+							var doc UserDoc
+							meta, err := reader.Read(&doc)
+							if shared.IsNoMoreDocuments(err) {
+								break
+							}
+							require.NoError(t, err)
+							require.NotEmpty(t, meta.Key)
+							require.Equal(t, "John", doc.Name)
+							keys[meta.Key] = struct{}{}
+						}
+						require.Len(t, keys, docCount)
+
+						_, err = reader.Read(&UserDoc{})
+						require.True(t, shared.IsNoMoreDocuments(err))
+					})
+
+					t.Run("stop reading early", func(t *testing.T) {
+						reader, err := col.All(ctx, &arangodb.AllDocumentsOptions{BatchSize: 100})
+						require.NoError(t, err)
+
+						for i := 0; i < 10; i++ {
+							var doc UserDoc
+							_, err := reader.Read(&doc)
+							require.NoError(t, err)
+						}
+						require.NoError(t, reader.Close())
+						require.NoError(t, reader.Close())
+
+						_, err = reader.Read(&UserDoc{})
+						require.True(t, shared.IsNoMoreDocuments(err))
+					})
+
+					t.Run("read keys only", func(t *testing.T) {
+						reader, err := col.All(ctx, &arangodb.AllDocumentsOptions{BatchSize: 500, KeysOnly: true})
+						require.NoError(t, err)
+						defer reader.Close()
+
+						count := 0
+						for {
+							var doc map[string]interface{}
+							meta, err := reader.Read(&doc)
+							if shared.IsNoMoreDocuments(err) {
+								break
+							}
+							require.NoError(t, err)
+							require.NotEmpty(t, meta.Key)
+							require.NotContains(t, doc, "name")
+							require.NotContains(t, doc, "age")
+							count++
+						}
+						require.Equal(t, docCount, count)
+					})
+
+					t.Run("read projected attributes", func(t *testing.T) {
+						reader, err := col.All(ctx, &arangodb.AllDocumentsOptions{Attributes: []string{"age"}})
+						require.NoError(t, err)
+						defer reader.Close()
+
+						count := 0
+						for {
+							var doc map[string]interface{}
+							meta, err := reader.Read(&doc)
+							if shared.IsNoMoreDocuments(err) {
+								break
+							}
+							require.NoError(t, err)
+							require.NotEmpty(t, meta.Key)
+							require.Contains(t, doc, "age")
+							require.NotContains(t, doc, "name")
+							count++
+						}
+						require.Equal(t, docCount, count)
+					})
+				})
+			})
+		})
+	})
+}
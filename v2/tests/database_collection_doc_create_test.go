@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func Test_DatabaseCollectionDocCreateOverwrite(t *testing.T) {
@@ -126,6 +127,58 @@ func Test_DatabaseCollectionDocCreateOverwrite(t *testing.T) {
 	})
 }
 
+func Test_DatabaseCollectionDocCreateMultipleOverwriteUpdate(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					_, err := col.CreateDocument(ctx, DocWithRev{Key: "existing-1", Name: "old-1"})
+					require.NoError(t, err)
+					_, err = col.CreateDocument(ctx, DocWithRev{Key: "existing-2", Name: "old-2"})
+					require.NoError(t, err)
+
+					docs := []DocWithRev{
+						{Key: "existing-1", Name: "new-1"},
+						{Key: "inserted-1", Name: "new-2"},
+						{Key: "existing-2", Name: "new-3"},
+						{Key: "inserted-2", Name: "new-4"},
+					}
+
+					var oldDoc, newDoc DocWithRev
+					overwriteMode := arangodb.CollectionDocumentCreateOverwriteModeUpdate
+					reader, err := col.CreateDocumentsWithOptions(ctx, docs, &arangodb.CollectionDocumentCreateOptions{
+						OverwriteMode: overwriteMode.New(),
+						OldObject:     &oldDoc,
+						NewObject:     &newDoc,
+					})
+					require.NoError(t, err)
+
+					expectedOld := map[string]string{"existing-1": "old-1", "existing-2": "old-2"}
+					for i, doc := range docs {
+						meta, err := reader.Read()
+						require.NoError(t, err)
+						require.Equal(t, doc.Key, meta.Key)
+
+						require.NotNil(t, meta.New)
+						require.Equal(t, doc.Name, newDoc.Name)
+						require.Equal(t, meta.Rev, newDoc.Rev)
+
+						if name, updated := expectedOld[doc.Key]; updated {
+							require.NotNil(t, meta.Old, "old document is expected for the document %d", i)
+							require.Equal(t, name, oldDoc.Name)
+						} else {
+							require.Nil(t, meta.Old, "old document is not expected for the document %d", i)
+						}
+					}
+
+					_, err = reader.Read()
+					require.True(t, shared.IsNoMoreDocuments(err))
+				})
+			})
+		})
+	})
+}
+
 func Test_DatabaseCollectionDocCreateKeepNull(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		WithDatabase(t, client, nil, func(db arangodb.Database) {
@@ -353,3 +353,67 @@ func Test_DatabaseCollectionDocDeleteWaitForSync(t *testing.T) {
 		})
 	})
 }
+
+func Test_DatabaseCollectionDocRemoveByFilter(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					docs := []UserDoc{
+						{Name: "Adam", Age: 10},
+						{Name: "Bob", Age: 20},
+						{Name: "Clara", Age: 30},
+						{Name: "Dave", Age: 40},
+						{Name: "Eve", Age: 50},
+					}
+					_, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+
+					removed, err := col.RemoveByFilter(ctx, "d.age >= @age", map[string]interface{}{"age": 30})
+					require.NoError(t, err)
+					require.Equal(t, int64(3), removed)
+
+					count, err := col.Count(ctx)
+					require.NoError(t, err)
+					require.Equal(t, int64(2), count)
+
+					query := "FOR d IN @@col SORT d.name RETURN d.name"
+					cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+						BindVars: map[string]interface{}{"@col": col.Name()},
+					})
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					var names []string
+					for cursor.HasMore() {
+						var name string
+						_, err := cursor.ReadDocument(ctx, &name)
+						require.NoError(t, err)
+						names = append(names, name)
+					}
+					require.Equal(t, []string{"Adam", "Bob"}, names)
+
+					t.Run("Injection is not possible via bind vars", func(t *testing.T) {
+						removed, err := col.RemoveByFilter(ctx, "d.name == @name", map[string]interface{}{"name": "x\" || true || \""})
+						require.NoError(t, err)
+						require.Equal(t, int64(0), removed)
+					})
+
+					t.Run("No documents match", func(t *testing.T) {
+						removed, err := col.RemoveByFilter(ctx, "d.age > @age", map[string]interface{}{"age": 100})
+						require.NoError(t, err)
+						require.Equal(t, int64(0), removed)
+					})
+
+					t.Run("Invalid arguments", func(t *testing.T) {
+						_, err := col.RemoveByFilter(ctx, " ", nil)
+						require.True(t, shared.IsInvalidArgument(err))
+
+						_, err = col.RemoveByFilter(ctx, "true", map[string]interface{}{"@collection": "other"})
+						require.True(t, shared.IsInvalidArgument(err))
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_DatabaseCollectionDocImportFromReader(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					input := strings.Join([]string{
+						`{"_key":"a","name":"a","age":1}`,
+						`{"_key":"b","name":"b"`,
+						``,
+						`{"_key":"c","name":"c","age":3}`,
+						`{"_key":"a","name":"duplicate"}`,
+					}, "\n")
+
+					t.Run("malformed lines are counted as errors", func(t *testing.T) {
+						stats, err := col.ImportFromReader(ctx, strings.NewReader(input), &arangodb.ImportDocumentOptions{
+							Details: true,
+						})
+						require.NoError(t, err)
+						require.Equal(t, int64(2), stats.Created)
+						require.Equal(t, int64(2), stats.Errors, "the malformed line and the duplicate are expected to fail")
+						require.Equal(t, int64(1), stats.Empty)
+						require.Len(t, stats.Details, 2)
+
+						var doc UserDoc
+						_, err = col.ReadDocument(ctx, "c", &doc)
+						require.NoError(t, err)
+						require.Equal(t, UserDoc{Name: "c", Age: 3}, doc)
+
+						_, err = col.ReadDocument(ctx, "a", &doc)
+						require.NoError(t, err)
+						require.Equal(t, "a", doc.Name)
+					})
+
+					t.Run("duplicates are updated", func(t *testing.T) {
+						stats, err := col.ImportFromReader(ctx, strings.NewReader(input), &arangodb.ImportDocumentOptions{
+							OnDuplicate: arangodb.ImportOnDuplicateUpdate,
+						})
+						require.NoError(t, err)
+						require.Equal(t, int64(0), stats.Created)
+						require.Equal(t, int64(3), stats.Updated)
+						require.Equal(t, int64(1), stats.Errors)
+
+						var doc UserDoc
+						_, err = col.ReadDocument(ctx, "a", &doc)
+						require.NoError(t, err)
+						require.Equal(t, UserDoc{Name: "duplicate", Age: 1}, doc)
+					})
+
+					t.Run("complete import fails on a malformed line", func(t *testing.T) {
+						_, err := col.ImportFromReader(ctx, strings.NewReader(input), &arangodb.ImportDocumentOptions{
+							OnDuplicate: arangodb.ImportOnDuplicateIgnore,
+							Complete:    true,
+						})
+						require.Error(t, err)
+					})
+				})
+			})
+		})
+	})
+}
@@ -22,6 +22,8 @@ package tests
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/arangodb/go-driver/v2/utils"
@@ -29,6 +31,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
 )
 
 func Test_DatabaseCollectionDocReadIfMatch(t *testing.T) {
@@ -88,6 +92,72 @@ func Test_DatabaseCollectionDocReadIfMatch(t *testing.T) {
 	})
 }
 
+func Test_DatabaseCollectionDocReadRevisionWithoutField(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					created, err := col.CreateDocument(ctx, UserDoc{Name: "John", Age: 13})
+					require.NoError(t, err)
+
+					var doc UserDoc
+					meta, err := col.ReadDocumentWithOptions(ctx, created.Key, &doc, nil)
+					require.NoError(t, err)
+					require.Equal(t, created.Rev, meta.Rev)
+					require.Equal(t, UserDoc{Name: "John", Age: 13}, doc)
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseCollectionDocReadTyped(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					created, err := arangodb.CreateTypedDocument(ctx, col, UserDoc{Name: "John", Age: 13})
+					require.NoError(t, err)
+					require.NotEmpty(t, created.Key)
+					require.NotEmpty(t, created.Rev)
+					require.Equal(t, arangodb.DocumentID(col.Name()+"/"+created.Key), created.ID)
+					require.Equal(t, UserDoc{Name: "John", Age: 13}, created.Data)
+
+					read, err := arangodb.ReadTypedDocument[UserDoc](ctx, col, created.Key)
+					require.NoError(t, err)
+					require.Equal(t, created, read)
+
+					t.Run("ReadDocument populates the document directly", func(t *testing.T) {
+						var doc arangodb.Document[UserDoc]
+						meta, err := col.ReadDocument(ctx, created.Key, &doc)
+						require.NoError(t, err)
+						require.Equal(t, meta, doc.DocumentMeta)
+						require.Equal(t, created.Data, doc.Data)
+					})
+
+					t.Run("Document can be written back", func(t *testing.T) {
+						read.Data.Age = 14
+						_, err := col.ReplaceDocumentWithOptions(ctx, read.Key, read, &arangodb.CollectionDocumentReplaceOptions{
+							IgnoreRevs: utils.NewType(false),
+						})
+						require.NoError(t, err)
+
+						updated, err := arangodb.ReadTypedDocument[UserDoc](ctx, col, created.Key)
+						require.NoError(t, err)
+						require.Equal(t, 14, updated.Data.Age)
+						require.NotEqual(t, read.Rev, updated.Rev)
+					})
+
+					t.Run("Missing document", func(t *testing.T) {
+						_, err := arangodb.ReadTypedDocument[UserDoc](ctx, col, "missing")
+						require.True(t, shared.IsNotFound(err))
+					})
+				})
+			})
+		})
+	})
+}
+
 func Test_DatabaseCollectionDocReadIgnoreRevs(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		WithDatabase(t, client, nil, func(db arangodb.Database) {
@@ -182,6 +252,187 @@ func Test_DatabaseCollectionDocReadAllowDirtyReads(t *testing.T) {
 						require.NoError(t, err)
 						require.Equal(t, metaRead.Key, meta.Key)
 					})
+
+					t.Run("WasDirtyRead is reported for single and bulk reads", func(t *testing.T) {
+						meta, err := col.CreateDocument(ctx, DocWithRev{Name: "test-dirty-read"})
+						require.NoError(t, err)
+
+						// In a cluster, the coordinator reports every read which is allowed to be served by a follower.
+						expected := getTestMode() == string(testModeCluster)
+
+						metaRead, err := col.ReadDocumentWithOptions(ctx, meta.Key, &DocWithRev{}, &arangodb.CollectionDocumentReadOptions{
+							AllowDirtyReads: utils.NewType(true),
+						})
+						require.NoError(t, err)
+						require.Equal(t, meta.Key, metaRead.Key)
+						require.Equal(t, expected, metaRead.WasDirtyRead)
+
+						r, err := col.ReadDocumentsWithOptions(ctx, []string{meta.Key}, &arangodb.CollectionDocumentReadOptions{
+							AllowDirtyReads: utils.NewType(true),
+						})
+						require.NoError(t, err)
+						readResp, err := r.Read(&DocWithRev{})
+						require.NoError(t, err)
+						require.Equal(t, expected, readResp.WasDirtyRead)
+
+						dirtyCtx := arangodb.WithAllowDirtyRead(ctx)
+
+						metaRead, err = col.ReadDocument(dirtyCtx, meta.Key, &DocWithRev{})
+						require.NoError(t, err)
+						require.Equal(t, expected, arangodb.WasDirtyRead(dirtyCtx))
+						require.Equal(t, expected, metaRead.WasDirtyRead)
+					})
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseCollectionDocReadWithAllowDirtyReadContext(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					meta, err := col.CreateDocument(ctx, DocWithRev{Name: "test-dirty-read-context"})
+					require.NoError(t, err)
+
+					dirtyCtx := arangodb.WithAllowDirtyRead(ctx)
+					require.False(t, arangodb.WasDirtyRead(dirtyCtx))
+
+					// The coordinator reports the read as potentially dirty only when the header has been sent.
+					metaRead, err := col.ReadDocument(dirtyCtx, meta.Key, &DocWithRev{})
+					require.NoError(t, err)
+					require.Equal(t, meta.Key, metaRead.Key)
+					require.True(t, arangodb.WasDirtyRead(dirtyCtx))
+
+					_, err = db.Query(dirtyCtx, "FOR d IN @@col RETURN d", &arangodb.QueryOptions{
+						BindVars: map[string]interface{}{"@col": col.Name()},
+					})
+					require.NoError(t, err)
+					require.True(t, arangodb.WasDirtyRead(dirtyCtx))
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseCollectionDocExists(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					meta, err := col.CreateDocument(ctx, UserDoc{Name: "exists", Age: 1})
+					require.NoError(t, err)
+
+					t.Run("existing document", func(t *testing.T) {
+						exists, err := col.DocumentExists(ctx, meta.Key)
+						require.NoError(t, err)
+						require.True(t, exists)
+
+						exists, rev, err := col.DocumentExistsWithRevision(ctx, meta.Key)
+						require.NoError(t, err)
+						require.True(t, exists)
+						require.Equal(t, meta.Rev, rev)
+					})
+
+					t.Run("missing document", func(t *testing.T) {
+						exists, err := col.DocumentExists(ctx, "missing")
+						require.NoError(t, err)
+						require.False(t, exists)
+
+						exists, rev, err := col.DocumentExistsWithRevision(ctx, "missing")
+						require.NoError(t, err)
+						require.False(t, exists)
+						require.Empty(t, rev)
+					})
+
+					t.Run("permission denied", func(t *testing.T) {
+						if os.Getenv("TEST_AUTHENTICATION") == "" {
+							t.Skip("Authentication is disabled")
+						}
+
+						userName := GenerateUUID("user-exists")
+						_, err := client.CreateUser(ctx, userName, &arangodb.UserOptions{Password: "secret"})
+						require.NoError(t, err)
+						defer client.RemoveUser(ctx, userName)
+
+						conn := connectionJsonHttp(t)
+						require.NoError(t, conn.SetAuthentication(connection.NewBasicAuth(userName, "secret")))
+
+						dbNoAccess, err := arangodb.NewClient(conn).GetDatabase(ctx, db.Name(), &arangodb.GetDatabaseOptions{SkipExistCheck: true})
+						require.NoError(t, err)
+						colNoAccess, err := dbNoAccess.GetCollection(ctx, col.Name(), &arangodb.GetCollectionOptions{SkipExistCheck: true})
+						require.NoError(t, err)
+
+						exists, err := colNoAccess.DocumentExists(ctx, meta.Key)
+						require.Error(t, err)
+						require.False(t, exists)
+						require.False(t, shared.IsNotFound(err), "permission error must not be reported as missing document")
+					})
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseCollectionExistingKeys(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					// More keys than fit in one batch.
+					const size = 2500
+
+					docs := make([]basicDocument, 0, size/2)
+					var keys, expected []string
+					for i := 0; i < size; i++ {
+						key := fmt.Sprintf("key-%d", i)
+						keys = append(keys, key)
+						if i%2 == 0 {
+							docs = append(docs, basicDocument{Key: key})
+							expected = append(expected, key)
+						}
+					}
+
+					reader, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+					for {
+						_, err := reader.Read()
+						if shared.IsNoMoreDocuments(err) {
+							break
+						}
+						require.NoError(t, err)
+					}
+
+					t.Run("mixed keys", func(t *testing.T) {
+						existing, err := col.ExistingKeys(ctx, keys)
+						require.NoError(t, err)
+						require.ElementsMatch(t, expected, existing)
+					})
+
+					t.Run("order and duplicates do not matter", func(t *testing.T) {
+						reversed := make([]string, 0, 2*len(keys))
+						for i := len(keys) - 1; i >= 0; i-- {
+							reversed = append(reversed, keys[i])
+						}
+						reversed = append(reversed, keys...)
+
+						existing, err := col.ExistingKeys(ctx, reversed)
+						require.NoError(t, err)
+						require.ElementsMatch(t, expected, existing)
+					})
+
+					t.Run("no existing keys", func(t *testing.T) {
+						existing, err := col.ExistingKeys(ctx, []string{"missing-1", "missing-2", "invalid/key"})
+						require.NoError(t, err)
+						require.Empty(t, existing)
+
+						existing, err = col.ExistingKeys(ctx, nil)
+						require.NoError(t, err)
+						require.Empty(t, existing)
+					})
 				})
 			})
 		})
@@ -22,6 +22,8 @@ package tests
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 
 	"github.com/arangodb/go-driver/v2/utils"
@@ -29,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func Test_DatabaseCollectionDocUpdateIfMatch(t *testing.T) {
@@ -76,6 +79,145 @@ func Test_DatabaseCollectionDocUpdateIfMatch(t *testing.T) {
 	})
 }
 
+func Test_DatabaseCollectionDocUpdateReturnTyped(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					t.Run("single document", func(t *testing.T) {
+						meta, err := col.CreateDocument(ctx, UserDoc{Name: "John", Age: 13})
+						require.NoError(t, err)
+
+						var oldDoc, newDoc DocWithRev
+						updated, err := col.UpdateDocumentWithOptions(ctx, meta.Key, map[string]interface{}{"age": 14},
+							&arangodb.CollectionDocumentUpdateOptions{
+								OldObject: &oldDoc,
+								NewObject: &newDoc,
+							})
+						require.NoError(t, err)
+
+						require.Equal(t, meta.Key, oldDoc.Key)
+						require.Equal(t, meta.Rev, oldDoc.Rev)
+						require.Equal(t, "John", oldDoc.Name)
+						require.Equal(t, 13, *oldDoc.Age)
+
+						require.Equal(t, updated.Rev, newDoc.Rev)
+						require.Equal(t, "John", newDoc.Name)
+						require.Equal(t, 14, *newDoc.Age)
+					})
+
+					t.Run("multiple documents", func(t *testing.T) {
+						docs := []UserDoc{{Name: "Adam", Age: 20}, {Name: "Eve", Age: 30}}
+						reader, err := col.CreateDocuments(ctx, docs)
+						require.NoError(t, err)
+
+						var updates []map[string]interface{}
+						for i := range docs {
+							meta, err := reader.Read()
+							require.NoError(t, err)
+							updates = append(updates, map[string]interface{}{"_key": meta.Key, "age": docs[i].Age + 1})
+						}
+
+						var oldDoc, newDoc UserDoc
+						updateReader, err := col.UpdateDocumentsWithOptions(ctx, updates, &arangodb.CollectionDocumentUpdateOptions{
+							OldObject: &oldDoc,
+							NewObject: &newDoc,
+						})
+						require.NoError(t, err)
+
+						for i := range docs {
+							_, err := updateReader.Read()
+							require.NoError(t, err)
+							require.Equal(t, docs[i], oldDoc)
+							require.Equal(t, UserDoc{Name: docs[i].Name, Age: docs[i].Age + 1}, newDoc)
+						}
+					})
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseCollectionDocUpdateWithRetry(t *testing.T) {
+	type counterDoc struct {
+		Count int `json:"count"`
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					meta, err := col.CreateDocument(ctx, counterDoc{})
+					require.NoError(t, err)
+
+					increment := func(current counterDoc) (counterDoc, error) {
+						current.Count++
+						return current, nil
+					}
+
+					t.Run("concurrent updates are not lost", func(t *testing.T) {
+						const updaters, updates = 5, 10
+
+						var wg sync.WaitGroup
+						errs := make(chan error, updaters*updates)
+						for i := 0; i < updaters; i++ {
+							wg.Add(1)
+							go func() {
+								defer wg.Done()
+								for j := 0; j < updates; j++ {
+									if _, err := arangodb.UpdateWithRetry(ctx, col, meta.Key, increment, 100); err != nil {
+										errs <- err
+									}
+								}
+							}()
+						}
+						wg.Wait()
+						close(errs)
+
+						for err := range errs {
+							require.NoError(t, err)
+						}
+
+						var doc counterDoc
+						_, err := col.ReadDocument(ctx, meta.Key, &doc)
+						require.NoError(t, err)
+						require.Equal(t, updaters*updates, doc.Count)
+					})
+
+					t.Run("modify error aborts the update", func(t *testing.T) {
+						abort := errors.New("abort")
+
+						_, err := arangodb.UpdateWithRetry(ctx, col, meta.Key, func(current counterDoc) (counterDoc, error) {
+							return current, abort
+						}, 1)
+						require.ErrorIs(t, err, abort)
+					})
+
+					t.Run("missing document", func(t *testing.T) {
+						_, err := arangodb.UpdateWithRetry(ctx, col, "missing", increment, 1)
+						require.True(t, shared.IsNotFound(err))
+					})
+
+					t.Run("fields missing from the type are kept", func(t *testing.T) {
+						created, err := col.CreateDocument(ctx, map[string]interface{}{"count": 1, "other": "kept"})
+						require.NoError(t, err)
+
+						resp, err := arangodb.UpdateWithRetry(ctx, col, created.Key, increment, 1)
+						require.NoError(t, err)
+						require.NotEqual(t, created.Rev, resp.Rev)
+
+						var doc map[string]interface{}
+						_, err = col.ReadDocument(ctx, created.Key, &doc)
+						require.NoError(t, err)
+						require.EqualValues(t, 2, doc["count"])
+						require.Equal(t, "kept", doc["other"])
+					})
+				})
+			})
+		})
+	})
+}
+
 func Test_DatabaseCollectionDocUpdateIgnoreRevs(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		WithDatabase(t, client, nil, func(db arangodb.Database) {
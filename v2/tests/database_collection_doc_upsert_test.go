@@ -0,0 +1,139 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_DatabaseCollectionDocUpsert(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					key := GenerateUUID("upsert")
+
+					var insertMeta arangodb.DocumentMeta
+
+					t.Run("insert when the document does not exist", func(t *testing.T) {
+						var newDoc UserDocWithMeta
+
+						resp, err := col.Upsert(ctx, key, UserDoc{Name: "John", Age: 13}, map[string]interface{}{"age": 14},
+							&arangodb.CollectionDocumentUpsertOptions{NewObject: &newDoc})
+						require.NoError(t, err)
+						require.True(t, resp.Inserted)
+						require.Equal(t, key, resp.Key)
+						require.NotEmpty(t, resp.Rev)
+						require.Equal(t, "John", newDoc.Name)
+						require.Equal(t, 13, newDoc.Age)
+
+						insertMeta = resp.DocumentMeta
+					})
+
+					t.Run("update when the document exists", func(t *testing.T) {
+						var oldDoc, newDoc UserDocWithMeta
+
+						resp, err := col.Upsert(ctx, key, UserDoc{Name: "John", Age: 13}, map[string]interface{}{"age": 14},
+							&arangodb.CollectionDocumentUpsertOptions{OldObject: &oldDoc, NewObject: &newDoc})
+						require.NoError(t, err)
+						require.False(t, resp.Inserted)
+						require.Equal(t, key, resp.Key)
+						require.NotEqual(t, insertMeta.Rev, resp.Rev)
+						require.Equal(t, 13, oldDoc.Age)
+						require.Equal(t, "John", newDoc.Name)
+						require.Equal(t, 14, newDoc.Age)
+					})
+
+					t.Run("update with keepNull disabled", func(t *testing.T) {
+						var newDoc map[string]interface{}
+
+						resp, err := col.Upsert(ctx, key, UserDoc{}, map[string]interface{}{"name": nil},
+							&arangodb.CollectionDocumentUpsertOptions{KeepNull: utils.NewType(false), NewObject: &newDoc})
+						require.NoError(t, err)
+						require.False(t, resp.Inserted)
+						require.NotContains(t, newDoc, "name")
+						require.Contains(t, newDoc, "age")
+					})
+
+					t.Run("replace when the document exists", func(t *testing.T) {
+						var newDoc map[string]interface{}
+
+						resp, err := col.Upsert(ctx, key, UserDoc{}, map[string]interface{}{"name": "Jake"},
+							&arangodb.CollectionDocumentUpsertOptions{Replace: true, NewObject: &newDoc})
+						require.NoError(t, err)
+						require.False(t, resp.Inserted)
+						require.Equal(t, "Jake", newDoc["name"])
+						require.NotContains(t, newDoc, "age")
+					})
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseCollectionDocUpsertConcurrent(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					const workers = 10
+
+					key := GenerateUUID("upsert")
+					results := make([]arangodb.CollectionDocumentUpsertResponse, workers)
+					errs := make([]error, workers)
+
+					var wg sync.WaitGroup
+					for i := 0; i < workers; i++ {
+						wg.Add(1)
+						go func(i int) {
+							defer wg.Done()
+
+							results[i], errs[i] = col.Upsert(ctx, key, UserDoc{Name: "John", Age: i}, map[string]interface{}{"age": i},
+								&arangodb.CollectionDocumentUpsertOptions{Exclusive: utils.NewType(true)})
+						}(i)
+					}
+					wg.Wait()
+
+					inserted := 0
+					for i := 0; i < workers; i++ {
+						require.NoError(t, errs[i])
+						require.Equal(t, key, results[i].Key)
+						if results[i].Inserted {
+							inserted++
+						}
+					}
+					require.Equal(t, 1, inserted)
+
+					count, err := col.Count(ctx)
+					require.NoError(t, err)
+					require.Equal(t, int64(1), count)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,148 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+type geoCity struct {
+	Name      string    `json:"name"`
+	Latitude  float64   `json:"lat"`
+	Longitude float64   `json:"lon"`
+	Location  []float64 `json:"location"`
+	Distance  float64   `json:"distance,omitempty"`
+}
+
+func newGeoCity(name string, latitude, longitude float64) geoCity {
+	return geoCity{Name: name, Latitude: latitude, Longitude: longitude, Location: []float64{longitude, latitude}}
+}
+
+func readGeoCities(t *testing.T, ctx context.Context, cursor arangodb.Cursor) []geoCity {
+	defer cursor.Close()
+
+	var cities []geoCity
+	for cursor.HasMore() {
+		var city geoCity
+		_, err := cursor.ReadDocument(ctx, &city)
+		require.NoError(t, err)
+		cities = append(cities, city)
+	}
+	return cities
+}
+
+func Test_CollectionGeoQueries(t *testing.T) {
+	const berlinLat, berlinLon = 52.5200, 13.4050
+
+	cities := []geoCity{
+		newGeoCity("Munich", 48.1351, 11.5820),
+		newGeoCity("Potsdam", 52.3906, 13.0645),
+		newGeoCity("Hamburg", 53.5511, 9.9937),
+		newGeoCity("Berlin", berlinLat, berlinLon),
+	}
+
+	// Approximate distances from Berlin in meters.
+	expectedDistances := map[string]float64{
+		"Berlin":  0,
+		"Potsdam": 26900,
+		"Hamburg": 255400,
+		"Munich":  504300,
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					_, err := col.CreateDocuments(ctx, cities)
+					require.NoError(t, err)
+
+					t.Run("No geo index", func(t *testing.T) {
+						_, err := col.Near(ctx, berlinLat, berlinLon, 2, nil)
+						require.True(t, shared.IsInvalidArgument(err))
+					})
+
+					_, _, err = col.EnsureGeoIndex(ctx, []string{"lat", "lon"}, &arangodb.CreateGeoIndexOptions{Name: "latlon"})
+					require.NoError(t, err)
+					_, _, err = col.EnsureGeoIndex(ctx, []string{"location"}, &arangodb.CreateGeoIndexOptions{
+						Name:    "geojson",
+						GeoJSON: utils.NewType(true),
+					})
+					require.NoError(t, err)
+
+					for _, index := range []string{"latlon", "geojson"} {
+						t.Run("Near with index "+index, func(t *testing.T) {
+							cursor, err := col.Near(ctx, berlinLat, berlinLon, 3, &arangodb.GeoQueryOptions{Index: index})
+							require.NoError(t, err)
+
+							result := readGeoCities(t, ctx, cursor)
+							require.Len(t, result, 3)
+							for i, name := range []string{"Berlin", "Potsdam", "Hamburg"} {
+								require.Equal(t, name, result[i].Name)
+								require.InDelta(t, expectedDistances[name], result[i].Distance, 1000)
+							}
+						})
+
+						t.Run("Within with index "+index, func(t *testing.T) {
+							cursor, err := col.Within(ctx, berlinLat, berlinLon, 300000, &arangodb.GeoQueryOptions{Index: index})
+							require.NoError(t, err)
+
+							result := readGeoCities(t, ctx, cursor)
+							require.Len(t, result, 3)
+							require.Equal(t, "Hamburg", result[2].Name)
+							for _, city := range result {
+								require.LessOrEqual(t, city.Distance, 300000.0)
+							}
+						})
+					}
+
+					t.Run("Custom distance attribute", func(t *testing.T) {
+						cursor, err := col.Within(ctx, berlinLat, berlinLon, 600000, &arangodb.GeoQueryOptions{
+							DistanceAttribute: "dist",
+							Limit:             1,
+						})
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						var doc map[string]interface{}
+						_, err = cursor.ReadDocument(ctx, &doc)
+						require.NoError(t, err)
+						require.Equal(t, "Berlin", doc["name"])
+						require.Contains(t, doc, "dist")
+						require.NotContains(t, doc, "distance")
+						require.False(t, cursor.HasMore())
+					})
+
+					t.Run("Unknown index", func(t *testing.T) {
+						_, err := col.Near(ctx, berlinLat, berlinLon, 2, &arangodb.GeoQueryOptions{Index: "missing"})
+						require.True(t, shared.IsInvalidArgument(err))
+					})
+				})
+			})
+		})
+	})
+}
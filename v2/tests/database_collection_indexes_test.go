@@ -293,6 +293,110 @@ func Test_TTLIndex(t *testing.T) {
 	})
 }
 
+func Test_TTLIndexExpireAfter(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					idx, created, err := col.EnsureTTLIndex(ctx, []string{"expiresAt"}, 3600, &arangodb.CreateTTLIndexOptions{
+						Name: "ttl-expires-at",
+					})
+					require.NoError(t, err)
+					require.True(t, created)
+
+					expireAfter, ok := idx.ExpireAfter()
+					require.True(t, ok)
+					require.Equal(t, 3600, expireAfter)
+
+					read, err := col.Index(ctx, idx.Name)
+					require.NoError(t, err)
+					require.Equal(t, arangodb.TTLIndexType, read.Type)
+					require.Equal(t, []string{"expiresAt"}, read.RegularIndex.Fields)
+
+					expireAfter, ok = read.ExpireAfter()
+					require.True(t, ok)
+					require.Equal(t, 3600, expireAfter)
+
+					t.Run("Not a TTL index", func(t *testing.T) {
+						idx, _, err := col.EnsurePersistentIndex(ctx, []string{"name"}, nil)
+						require.NoError(t, err)
+
+						_, ok := idx.ExpireAfter()
+						require.False(t, ok)
+					})
+				})
+			})
+		})
+	})
+}
+
+func Test_EnsureMDIndex(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					skipBelowVersion(client, ctx, "3.9", t)
+
+					version, err := client.Version(ctx)
+					require.NoError(t, err)
+
+					expectedType := arangodb.MDIIndexType
+					if version.Version.CompareTo("3.12") < 0 {
+						expectedType = arangodb.ZKDIndexType
+					}
+
+					idx, created, err := col.EnsureMDIndex(ctx, []string{"x", "y"}, &arangodb.CreateMDIIndexOptions{
+						Name:            "md-x-y",
+						FieldValueTypes: arangodb.MDIDoubleFieldType,
+					})
+					require.NoError(t, err)
+					require.True(t, created)
+					require.Equal(t, expectedType, idx.Type)
+					require.Equal(t, []string{"x", "y"}, idx.RegularIndex.Fields)
+
+					_, created, err = col.EnsureMDIndex(ctx, []string{"x", "y"}, &arangodb.CreateMDIIndexOptions{
+						Name:            "md-x-y",
+						FieldValueTypes: arangodb.MDIDoubleFieldType,
+					})
+					require.NoError(t, err)
+					require.False(t, created)
+
+					err = arangodb.CreateDocuments(ctx, col, 20, func(index int) any {
+						return map[string]interface{}{"x": float64(index), "y": float64(20 - index)}
+					})
+					require.NoError(t, err)
+
+					query := "FOR d IN @@col FILTER d.x >= 5 && d.x <= 10 && d.y >= 12 && d.y <= 14 RETURN d"
+					bindVars := map[string]interface{}{"@col": col.Name()}
+
+					cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{BindVars: bindVars, Count: true})
+					require.NoError(t, err)
+					defer cursor.Close()
+					require.Equal(t, int64(3), cursor.Count())
+
+					explain, err := db.ExplainQuery(ctx, query, bindVars, nil)
+					require.NoError(t, err)
+
+					var used bool
+					for _, node := range explain.Plan.NodesRaw {
+						if node["type"] != "IndexNode" {
+							continue
+						}
+						indexes, ok := node["indexes"].([]interface{})
+						require.True(t, ok)
+						for _, i := range indexes {
+							if index, ok := i.(map[string]interface{}); ok && index["name"] == idx.Name {
+								used = true
+							}
+						}
+					}
+					require.True(t, used, "multi-dimensional index is not used by the query")
+				})
+			})
+		})
+	})
+}
+
 func Test_EnsureGeoIndexIndex(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		WithDatabase(t, client, nil, func(db arangodb.Database) {
@@ -22,7 +22,9 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 
 	"github.com/arangodb/go-driver/v2/arangodb"
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -108,6 +111,202 @@ func Test_CollectionShards(t *testing.T) {
 	})
 }
 
+func Test_CollectionResponsibleShard(t *testing.T) {
+	requireClusterMode(t)
+
+	options := arangodb.CreateCollectionProperties{
+		NumberOfShards: 4,
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, &options, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					shards, err := col.Shards(ctx, false)
+					require.NoError(t, err)
+
+					responsible := map[arangodb.ShardID]bool{}
+					for i := 0; i < 20; i++ {
+						shardID, err := col.ResponsibleShard(ctx, basicDocument{Key: fmt.Sprintf("key-%d", i)})
+						require.NoError(t, err)
+						require.Contains(t, shards.Shards, shardID)
+						responsible[shardID] = true
+					}
+					require.Greater(t, len(responsible), 1, "all the documents are mapped to the same shard")
+
+					shardID, err := col.ResponsibleShard(ctx, basicDocument{Key: "key-0"})
+					require.NoError(t, err)
+					again, err := col.ResponsibleShard(ctx, map[string]interface{}{"_key": "key-0", "other": true})
+					require.NoError(t, err)
+					require.Equal(t, shardID, again)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionDistributeShardsLike(t *testing.T) {
+	requireClusterMode(t)
+
+	parentOptions := arangodb.CreateCollectionProperties{
+		NumberOfShards:   3,
+		ShardKeys:        []string{"tenant"},
+		ShardingStrategy: arangodb.ShardingStrategyHash,
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, &parentOptions, func(parent arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					childOptions := arangodb.CreateCollectionProperties{
+						DistributeShardsLike: parent.Name(),
+						ShardKeys:            []string{"tenant"},
+					}
+
+					WithCollection(t, db, &childOptions, func(child arangodb.Collection) {
+						props, err := child.Properties(ctx)
+						require.NoError(t, err)
+						require.Equal(t, parent.Name(), props.DistributeShardsLike)
+						require.Equal(t, 3, props.NumberOfShards)
+						require.Equal(t, []string{"tenant"}, props.ShardKeys)
+
+						// The shards of both collections are co-located.
+						parentShards, err := parent.Shards(ctx, true)
+						require.NoError(t, err)
+						childShards, err := child.Shards(ctx, true)
+						require.NoError(t, err)
+						require.Equal(t, sortedShardLeaders(parentShards), sortedShardLeaders(childShards))
+					})
+
+					t.Run("nonexistent prototype collection", func(t *testing.T) {
+						_, err := db.CreateCollection(ctx, GenerateUUID("test-COL"), &arangodb.CreateCollectionProperties{
+							DistributeShardsLike: "nonexistent",
+						})
+						require.Error(t, err)
+						ok, _ := shared.IsArangoError(err)
+						require.True(t, ok, "server error is expected")
+					})
+				})
+			})
+		})
+	})
+}
+
+// sortedShardLeaders returns the leaders of the collection shards in the order of the shard IDs.
+func sortedShardLeaders(shards arangodb.CollectionShards) []arangodb.ServerID {
+	ids := make([]string, 0, len(shards.Shards))
+	for id := range shards.Shards {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+
+	leaders := make([]arangodb.ServerID, 0, len(ids))
+	for _, id := range ids {
+		leaders = append(leaders, shards.Shards[arangodb.ShardID(id)][0])
+	}
+	return leaders
+}
+
+func Test_CollectionResponsibleShardSingleServer(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					shardID, err := col.ResponsibleShard(ctx, basicDocument{Key: "key-0"})
+					require.NoError(t, err)
+					require.Equal(t, arangodb.ShardID(col.Name()), shardID)
+				})
+			})
+		})
+	})
+}
+
+// Test_CollectionWaitForSync waits until the shards of a replicated collection are in sync.
+func Test_CollectionWaitForSync(t *testing.T) {
+	requireClusterMode(t)
+
+	options := arangodb.CreateCollectionProperties{
+		ReplicationFactor: 2,
+		NumberOfShards:    3,
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, &options, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					require.NoError(t, col.WaitForSync(ctx, time.Minute))
+
+					shards, err := col.Shards(ctx, true)
+					require.NoError(t, err)
+					require.Len(t, shards.Shards, 3)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionSchema(t *testing.T) {
+	var rule interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"properties": {"name": {"type": "string"}, "age": {"type": "number", "minimum": 0}},
+		"required": ["name"]
+	}`), &rule))
+
+	createOpts := arangodb.CreateCollectionProperties{
+		Schema: &arangodb.CollectionSchemaOptions{
+			Rule:    rule,
+			Level:   arangodb.CollectionSchemaLevelStrict,
+			Message: "invalid user",
+		},
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, &createOpts, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					props, err := col.Properties(ctx)
+					require.NoError(t, err)
+					require.NotNil(t, props.Schema)
+					require.Equal(t, arangodb.CollectionSchemaLevelStrict, props.Schema.Level)
+					require.Equal(t, "invalid user", props.Schema.Message)
+					require.NotNil(t, props.Schema.Rule)
+
+					t.Run("valid document", func(t *testing.T) {
+						_, err := col.CreateDocument(ctx, UserDoc{Name: "John", Age: 13})
+						require.NoError(t, err)
+					})
+
+					t.Run("invalid document", func(t *testing.T) {
+						_, err := col.CreateDocument(ctx, map[string]interface{}{"age": -1})
+						require.Error(t, err)
+						require.True(t, shared.IsSchemaValidationFailed(err))
+						require.Contains(t, err.Error(), "invalid user")
+					})
+
+					t.Run("validation disabled", func(t *testing.T) {
+						err := col.SetProperties(ctx, arangodb.SetCollectionPropertiesOptions{
+							Schema: &arangodb.CollectionSchemaOptions{
+								Rule:  rule,
+								Level: arangodb.CollectionSchemaLevelNone,
+							},
+						})
+						require.NoError(t, err)
+
+						props, err := col.Properties(ctx)
+						require.NoError(t, err)
+						require.Equal(t, arangodb.CollectionSchemaLevelNone, props.Schema.Level)
+
+						_, err = col.CreateDocument(ctx, map[string]interface{}{"age": -1})
+						require.NoError(t, err)
+					})
+				})
+			})
+		})
+	})
+}
+
 // Test_CollectionSetProperties tries to set properties to collection
 func Test_CollectionSetProperties(t *testing.T) {
 	createOpts := arangodb.CreateCollectionProperties{
@@ -509,3 +708,302 @@ func Test_DatabaseCollectionTruncate(t *testing.T) {
 		})
 	})
 }
+
+func Test_CollectionRecalculateCount(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					size := 10
+					_, err := col.CreateDocuments(ctx, newDocs(size))
+					require.NoError(t, err)
+
+					count, err := col.RecalculateCount(ctx)
+					require.NoError(t, err)
+					require.Equal(t, int64(size), count)
+
+					cachedCount, err := col.Count(ctx)
+					require.NoError(t, err)
+					require.Equal(t, cachedCount, count)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionCompact(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					size := 1000
+					_, err := col.CreateDocuments(ctx, newDocs(size))
+					require.NoError(t, err)
+
+					removed, err := col.RemoveByFilter(ctx, "true", nil)
+					require.NoError(t, err)
+					require.Equal(t, int64(size), removed)
+
+					require.NoError(t, col.Compact(ctx))
+
+					count, err := col.Count(ctx)
+					require.NoError(t, err)
+					require.Zero(t, count)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionRevisionTreeSummary(t *testing.T) {
+	// Coordinators do not serve the revision trees, the single server does it like a DB-Server.
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					props, err := col.Properties(ctx)
+					require.NoError(t, err)
+					if !props.SyncByRevision {
+						t.Skip("Collection does not use the revision-based replication")
+					}
+					require.True(t, props.UsesRevisionsAsDocumentIds)
+
+					size := 100
+					_, err = col.CreateDocuments(ctx, newDocs(size))
+					require.NoError(t, err)
+
+					summary, err := col.RevisionTreeSummary(ctx)
+					require.NoError(t, err)
+					require.Equal(t, uint64(size), summary.Count)
+					require.NotEmpty(t, summary.RangeMin)
+					require.NotEmpty(t, summary.RangeMax)
+					require.NotZero(t, summary.Leaves)
+					require.NotZero(t, summary.PopulatedLeaves)
+					require.LessOrEqual(t, summary.PopulatedLeaves, summary.Leaves)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionDocumentRevisions(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					if getTestMode() == string(testModeCluster) {
+						// The client is connected to a Coordinator, which does not serve the revision trees.
+						_, err := col.DocumentRevisions(ctx, "key")
+						require.True(t, shared.IsPreconditionFailed(err), "expected precondition failed, got %v", err)
+						return
+					}
+					requireSingleMode(t)
+
+					props, err := col.Properties(ctx)
+					require.NoError(t, err)
+					if !props.SyncByRevision {
+						t.Skip("Collection does not use the revision-based replication")
+					}
+
+					_, err = col.CreateDocuments(ctx, newDocs(10))
+					require.NoError(t, err)
+
+					_, err = col.CreateDocument(ctx, map[string]interface{}{"_key": "doc", "age": 1})
+					require.NoError(t, err)
+					meta, err := col.UpdateDocument(ctx, "doc", map[string]interface{}{"age": 2})
+					require.NoError(t, err)
+
+					revisions, err := col.DocumentRevisions(ctx, "doc")
+					require.NoError(t, err)
+					require.Len(t, revisions, 1)
+					require.Equal(t, "doc", revisions[0]["_key"])
+					require.Equal(t, meta.Rev, revisions[0]["_rev"])
+					require.EqualValues(t, 2, revisions[0]["age"])
+
+					revisions, err = col.DocumentRevisions(ctx, "missing")
+					require.NoError(t, err)
+					require.Empty(t, revisions)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionRevisionAndCount(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					before, err := col.RevisionAndCount(ctx)
+					require.NoError(t, err)
+					require.NotEmpty(t, before.Revision)
+					require.Equal(t, int64(0), before.Count)
+
+					_, err = col.CreateDocument(ctx, UserDoc{Name: "a", Age: 1})
+					require.NoError(t, err)
+
+					after, err := col.RevisionAndCount(ctx)
+					require.NoError(t, err)
+					require.NotEqual(t, before.Revision, after.Revision)
+					require.Equal(t, int64(1), after.Count)
+
+					unchanged, err := col.RevisionAndCount(ctx)
+					require.NoError(t, err)
+					require.Equal(t, after, unchanged)
+				})
+			})
+		})
+	})
+}
+
+func Test_CreateCollectionWaitForReady(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		endpoints := client.Connection().GetEndpoint().List()
+		if len(endpoints) < 2 {
+			t.Skip("at least two coordinator endpoints are required")
+		}
+
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				name := GenerateUUID("test-wait-for-ready")
+				col, err := db.CreateCollectionWithOptions(ctx, name, nil, &arangodb.CreateCollectionOptions{
+					WaitForReady:        true,
+					WaitForReadyTimeout: time.Minute,
+				})
+				require.NoError(t, err)
+				defer func() {
+					require.NoError(t, col.Remove(ctx))
+				}()
+
+				for _, endpoint := range endpoints {
+					_, err := col.CreateDocument(connection.WithEndpoint(ctx, endpoint), UserDoc{Name: endpoint})
+					require.NoError(t, err, "collection is not usable on %s", endpoint)
+				}
+			})
+		})
+	})
+}
+
+func Test_WriteConcernNotMet(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				health, err := client.Health(ctx)
+				require.NoError(t, err)
+
+				dbServers := 0
+				for _, s := range health.Health {
+					if s.Role == arangodb.ServerRoleDBServer {
+						dbServers++
+					}
+				}
+
+				// There are not enough DB servers to keep the requested number of the in-sync copies.
+				name := GenerateUUID("test-write-concern")
+				col, err := db.CreateCollectionWithOptions(ctx, name, &arangodb.CreateCollectionProperties{
+					ReplicationFactor: arangodb.ReplicationFactor(dbServers + 1),
+					WriteConcern:      dbServers + 1,
+				}, &arangodb.CreateCollectionOptions{
+					EnforceReplicationFactor: utils.NewType(false),
+				})
+				require.NoError(t, err)
+				defer func() {
+					require.NoError(t, col.Remove(ctx))
+				}()
+
+				_, err = col.CreateDocument(ctx, UserDoc{Name: "write-concern"})
+				require.Error(t, err)
+				require.True(t, arangodb.IsWriteConcernNotMet(err), "unexpected error: %v", err)
+				require.True(t, shared.IsWriteConcernNotMet(err))
+
+				var wcErr arangodb.WriteConcernNotMetError
+				require.ErrorAs(t, err, &wcErr)
+				require.Equal(t, name, wcErr.Collection)
+			})
+		})
+	})
+}
+
+func Test_CollectionRename(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					oldName := col.Name()
+					newName := GenerateUUID("test-col-renamed")
+
+					meta, err := col.CreateDocument(ctx, UserDoc{Name: "renamed", Age: 1})
+					require.NoError(t, err)
+
+					err = col.Rename(ctx, newName)
+					require.NoError(t, err)
+					require.Equal(t, newName, col.Name())
+
+					exists, err := db.CollectionExists(ctx, oldName)
+					require.NoError(t, err)
+					require.False(t, exists, "old name must be gone")
+
+					exists, err = db.CollectionExists(ctx, newName)
+					require.NoError(t, err)
+					require.True(t, exists, "new name must exist")
+
+					// The handle uses the new name.
+					var doc UserDoc
+					_, err = col.ReadDocument(ctx, meta.Key, &doc)
+					require.NoError(t, err)
+					require.Equal(t, "renamed", doc.Name)
+					require.Equal(t, newName+"/"+meta.Key, col.DocumentID(meta.Key))
+				})
+			})
+		})
+	})
+}
+
+func Test_DatabaseOrphanedCollections(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				orphaned, err := db.OrphanedCollections(ctx)
+				require.NoError(t, err)
+				require.Empty(t, orphaned)
+
+				gDef := arangodb.GraphDefinition{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{
+							Collection: "edges",
+							From:       []string{"from"},
+							To:         []string{"to"},
+						},
+					},
+					OrphanCollections: []string{"orphan"},
+				}
+				_, err = db.CreateGraph(ctx, "graph", &gDef, nil)
+				require.NoError(t, err)
+
+				for _, name := range []string{"free2", "linked", "free1"} {
+					_, err := db.CreateCollection(ctx, name, nil)
+					require.NoError(t, err)
+				}
+
+				_, err = db.CreateArangoSearchView(ctx, "view", &arangodb.ArangoSearchViewProperties{
+					Links: arangodb.ArangoSearchLinks{
+						"linked": arangodb.ArangoSearchElementProperties{},
+					},
+				})
+				require.NoError(t, err)
+
+				orphaned, err = db.OrphanedCollections(ctx)
+				require.NoError(t, err)
+				require.Equal(t, []string{"free1", "free2"}, orphaned)
+			})
+		})
+	})
+}
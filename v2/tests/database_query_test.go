@@ -23,13 +23,544 @@ package tests
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/utils"
 )
 
+// Test_QueryParseErrorLocation checks that the location of the syntax error is available.
+func Test_QueryParseErrorLocation(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				query := "FOR i IN 1..10\n  FILTER i >>> 5\n  RETURN i"
+
+				validateErr := db.ValidateQuery(ctx, query)
+				require.Error(t, validateErr)
+				require.True(t, shared.IsQueryParseError(validateErr))
+
+				_, explainErr := db.ExplainQuery(ctx, query, nil, nil)
+				require.Error(t, explainErr)
+
+				for _, err := range []error{validateErr, explainErr} {
+					location, ok := shared.GetQueryErrorLocation(err)
+					require.True(t, ok, "location not found in: %s", err)
+					require.Equal(t, 2, location.Line)
+					require.Greater(t, location.Column, 0)
+					require.Greater(t, location.Offset(query), len("FOR i IN 1..10\n"))
+				}
+			})
+		})
+	})
+}
+
+// Test_ParseQuery checks that a query is parsed without being executed.
+func Test_ParseQuery(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					t.Run("valid query", func(t *testing.T) {
+						query := "FOR d IN @@col FILTER d.age > @age RETURN d"
+
+						result, err := db.ParseQuery(ctx, query)
+						require.NoError(t, err)
+						require.True(t, result.Parsed)
+						require.ElementsMatch(t, []string{"@col", "age"}, result.BindVars)
+						require.NotEmpty(t, result.AST)
+						require.Equal(t, "root", result.AST[0].Type)
+					})
+
+					t.Run("collections", func(t *testing.T) {
+						result, err := db.ParseQuery(ctx, "FOR d IN "+col.Name()+" RETURN d")
+						require.NoError(t, err)
+						require.Equal(t, []string{col.Name()}, result.Collections)
+						require.Empty(t, result.BindVars)
+					})
+
+					t.Run("syntax error", func(t *testing.T) {
+						query := "FOR i IN 1..10\n  RETURN i +"
+
+						_, err := db.ParseQuery(ctx, query)
+						require.Error(t, err)
+						require.True(t, shared.IsQueryParseError(err))
+
+						location, ok := shared.GetQueryErrorLocation(err)
+						require.True(t, ok, "location not found in: %s", err)
+						require.Equal(t, 2, location.Line)
+						require.Greater(t, location.Column, 0)
+					})
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryCache checks that the query results are served from the AQL query cache.
+func Test_QueryCache(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					original, err := db.QueryCacheProperties(ctx)
+					require.NoError(t, err)
+					defer func() {
+						_, err := db.SetQueryCacheProperties(ctx, original)
+						require.NoError(t, err)
+					}()
+
+					props, err := db.SetQueryCacheProperties(ctx, arangodb.QueryCacheProperties{
+						Mode:       arangodb.QueryCacheModeDemand,
+						MaxResults: utils.NewType[uint64](64),
+					})
+					require.NoError(t, err)
+					require.Equal(t, arangodb.QueryCacheModeDemand, props.Mode)
+					require.Equal(t, uint64(64), *props.MaxResults)
+
+					require.NoError(t, db.ClearQueryCache(ctx))
+
+					_, err = col.CreateDocument(ctx, UserDoc{Name: "John", Age: 13})
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR d IN `%s` RETURN d", col.Name())
+					runQuery := func(cache bool) bool {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{Cache: utils.NewType(cache)})
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						var doc UserDoc
+						_, err = cursor.ReadDocument(ctx, &doc)
+						require.NoError(t, err)
+						require.Equal(t, "John", doc.Name)
+
+						return cursor.Cached()
+					}
+
+					require.False(t, runQuery(true), "first execution must not be cached")
+					require.True(t, runQuery(true), "second execution must be cached")
+					require.False(t, runQuery(false), "cache must not be used when disabled for the query")
+
+					t.Run("Entries", func(t *testing.T) {
+						entries, err := db.QueryCacheEntries(ctx)
+						require.NoError(t, err)
+
+						var found *arangodb.QueryCacheEntry
+						for i := range entries {
+							if entries[i].Query == query {
+								found = &entries[i]
+							}
+						}
+						require.NotNil(t, found, "query must be in the cache")
+						require.Equal(t, uint64(1), found.Results)
+						require.GreaterOrEqual(t, found.Hits, uint64(1))
+						require.NotZero(t, found.Size)
+						require.Contains(t, found.DataSources, col.Name())
+					})
+
+					require.NoError(t, db.ClearQueryCache(ctx))
+					require.False(t, runQuery(true), "cache must be empty after clearing")
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryWarnings checks that the query warnings are available on the cursor.
+func Test_QueryWarnings(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				cursor, err := db.Query(ctx, "RETURN 1 / 0", nil)
+				require.NoError(t, err)
+				defer cursor.Close()
+
+				warnings := cursor.Warnings()
+				require.Len(t, warnings, 1)
+				require.Equal(t, 1562, warnings[0].Code)
+				require.Contains(t, warnings[0].Message, "division by zero")
+
+				t.Run("No warnings", func(t *testing.T) {
+					cursor, err := db.Query(ctx, "RETURN 1 / 1", nil)
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					require.Empty(t, cursor.Warnings())
+				})
+
+				t.Run("Fail on warning", func(t *testing.T) {
+					_, err := db.Query(ctx, "RETURN 1 / 0", &arangodb.QueryOptions{
+						Options: arangodb.QuerySubOptions{FailOnWarning: utils.NewType(true)},
+					})
+					require.Error(t, err)
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryStatistics checks that the execution statistics of the indexed and full collection scans are available.
+func Test_QueryStatistics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					const docCount = 20
+
+					err := arangodb.CreateDocuments(ctx, col, docCount, func(index int) any {
+						return UserDoc{Name: fmt.Sprintf("user-%d", index), Age: index}
+					})
+					require.NoError(t, err)
+
+					_, _, err = col.EnsurePersistentIndex(ctx, []string{"age"}, nil)
+					require.NoError(t, err)
+
+					queryStats := func(query string) arangodb.CursorStats {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+							BindVars: map[string]interface{}{"@col": col.Name()},
+						})
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						return cursor.Statistics()
+					}
+
+					t.Run("Indexed", func(t *testing.T) {
+						stats := queryStats("FOR d IN @@col FILTER d.age == 3 RETURN d")
+						require.Equal(t, uint64(1), stats.ScannedIndexInt)
+						require.Zero(t, stats.ScannedFullInt)
+					})
+
+					t.Run("Not indexed", func(t *testing.T) {
+						stats := queryStats("FOR d IN @@col FILTER d.name == 'user-3' RETURN d")
+						require.Equal(t, uint64(docCount), stats.ScannedFullInt)
+						require.Zero(t, stats.ScannedIndexInt)
+						require.Equal(t, uint64(docCount-1), stats.FilteredInt)
+					})
+
+					t.Run("Writes", func(t *testing.T) {
+						stats := queryStats("FOR d IN @@col FILTER d.age < 5 UPDATE d WITH { updated: true } IN @@col")
+						require.Equal(t, uint64(5), stats.WritesExecutedInt)
+						require.Zero(t, stats.WritesIgnoredInt)
+						require.Greater(t, stats.ExecutionTimeInt, 0.0)
+					})
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryCountAndFullCount checks that the total count and the count without LIMIT are reported separately.
+func Test_QueryCountAndFullCount(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				query := "FOR i IN 1..100 FILTER i % 2 == 0 LIMIT 10 RETURN i"
+
+				t.Run("Count and FullCount", func(t *testing.T) {
+					cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+						Count:     true,
+						BatchSize: 3,
+						Options:   arangodb.QuerySubOptions{FullCount: true},
+					})
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					require.Equal(t, int64(10), cursor.Count())
+					require.Equal(t, int64(50), cursor.FullCount())
+
+					var read int
+					for cursor.HasMore() {
+						var i int
+						_, err := cursor.ReadDocument(ctx, &i)
+						require.NoError(t, err)
+						read++
+					}
+					require.Equal(t, 10, read)
+					require.Equal(t, int64(10), cursor.Count())
+					require.Equal(t, int64(50), cursor.FullCount())
+				})
+
+				t.Run("Not requested", func(t *testing.T) {
+					cursor, err := db.Query(ctx, query, nil)
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					require.Equal(t, int64(-1), cursor.Count())
+					require.Equal(t, int64(-1), cursor.FullCount())
+				})
+			})
+		})
+	})
+}
+
+// Test_BatchQuery checks that the queries sent in a single batch request return independent results.
+func Test_BatchQuery(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					err := arangodb.CreateDocuments(ctx, col, 10, func(index int) any {
+						return UserDoc{Name: fmt.Sprintf("user-%d", index), Age: index}
+					})
+					require.NoError(t, err)
+
+					responses, err := db.BatchQuery(ctx, []arangodb.BatchQueryRequest{
+						{
+							Query:    "FOR d IN @@col FILTER d.age < @age SORT d.age RETURN d.name",
+							BindVars: map[string]interface{}{"@col": col.Name(), "age": 3},
+						},
+						{
+							Query:    "RETURN LENGTH(@@col)",
+							BindVars: map[string]interface{}{"@col": col.Name()},
+						},
+						{
+							Query: "FOR d IN missing_collection RETURN d",
+						},
+					})
+					require.NoError(t, err)
+					require.Len(t, responses, 3)
+
+					require.NoError(t, responses[0].Err)
+					defer responses[0].Cursor.Close()
+					var names []string
+					for responses[0].Cursor.HasMore() {
+						var name string
+						_, err := responses[0].Cursor.ReadDocument(ctx, &name)
+						require.NoError(t, err)
+						names = append(names, name)
+					}
+					require.Equal(t, []string{"user-0", "user-1", "user-2"}, names)
+
+					require.NoError(t, responses[1].Err)
+					defer responses[1].Cursor.Close()
+					var length int
+					_, err = responses[1].Cursor.ReadDocument(ctx, &length)
+					require.NoError(t, err)
+					require.Equal(t, 10, length)
+
+					require.Nil(t, responses[2].Cursor)
+					require.True(t, shared.IsNotFound(responses[2].Err))
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryGenerics checks that the query results are decoded into the given type.
+func Test_QueryGenerics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					const docCount = 10
+
+					err := arangodb.CreateDocuments(ctx, col, docCount, func(index int) any {
+						return UserDoc{Name: fmt.Sprintf("user-%d", index), Age: index}
+					})
+					require.NoError(t, err)
+
+					query := "FOR d IN @@col FILTER d.age >= @age SORT d.age RETURN d"
+					bindVars := map[string]interface{}{"@col": col.Name(), "age": 5}
+
+					t.Run("QueryAll", func(t *testing.T) {
+						users, err := arangodb.QueryAll[UserDoc](ctx, db, query, bindVars)
+						require.NoError(t, err)
+						require.Len(t, users, 5)
+						for i, user := range users {
+							require.Equal(t, UserDoc{Name: fmt.Sprintf("user-%d", i+5), Age: i + 5}, user)
+						}
+					})
+
+					t.Run("QueryAll with no results", func(t *testing.T) {
+						users, err := arangodb.QueryAll[UserDoc](ctx, db, query,
+							map[string]interface{}{"@col": col.Name(), "age": docCount})
+						require.NoError(t, err)
+						require.Empty(t, users)
+					})
+
+					t.Run("QueryAll with invalid query", func(t *testing.T) {
+						_, err := arangodb.QueryAll[UserDoc](ctx, db, "FOR d IN", nil)
+						require.Error(t, err)
+						require.True(t, shared.IsArangoErrorWithErrorNum(err, shared.ErrQueryParse))
+					})
+
+					t.Run("QueryStream", func(t *testing.T) {
+						docs, errs := arangodb.QueryStream[UserDoc](ctx, db, query, bindVars)
+
+						var users []UserDoc
+						for user := range docs {
+							users = append(users, user)
+						}
+						require.NoError(t, <-errs)
+						require.Len(t, users, 5)
+						require.Equal(t, UserDoc{Name: "user-9", Age: 9}, users[4])
+					})
+
+					t.Run("QueryStream stopped by the consumer", func(t *testing.T) {
+						streamCtx, cancel := context.WithCancel(ctx)
+						docs, errs := arangodb.QueryStream[UserDoc](streamCtx, db, query, bindVars)
+
+						user, ok := <-docs
+						require.True(t, ok)
+						require.Equal(t, UserDoc{Name: "user-5", Age: 5}, user)
+						cancel()
+
+						require.ErrorIs(t, <-errs, context.Canceled)
+						_, ok = <-docs
+						require.False(t, ok)
+					})
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryValidateBindVars checks that the bind parameters are validated before the query is sent.
+func Test_QueryValidateBindVars(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					query := "FOR d IN @@col FILTER d.age > @age RETURN d"
+
+					t.Run("valid", func(t *testing.T) {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+							BindVars:         map[string]interface{}{"@col": col.Name(), "age": 3},
+							ValidateBindVars: true,
+						})
+						require.NoError(t, err)
+						require.NoError(t, cursor.Close())
+					})
+
+					t.Run("missing", func(t *testing.T) {
+						_, err := db.Query(ctx, query, &arangodb.QueryOptions{
+							BindVars:         map[string]interface{}{"@col": col.Name()},
+							ValidateBindVars: true,
+						})
+						require.True(t, shared.IsInvalidArgument(err))
+					})
+
+					t.Run("not validated", func(t *testing.T) {
+						_, err := db.Query(ctx, query, &arangodb.QueryOptions{
+							BindVars: map[string]interface{}{"@col": col.Name()},
+						})
+						require.Error(t, err)
+						require.False(t, shared.IsInvalidArgument(err))
+					})
+				})
+			})
+		})
+	})
+}
+
+// Test_RunningQueries checks that the running queries are listed and can be killed.
+func Test_RunningQueries(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				// The queries are tracked by the coordinator which runs them.
+				ctx = connection.WithEndpoint(ctx, client.Connection().GetEndpoint().List()[0])
+
+				original, err := db.QueryTrackingProperties(ctx)
+				require.NoError(t, err)
+				defer func() {
+					_, err := db.SetQueryTrackingProperties(ctx, original)
+					require.NoError(t, err)
+				}()
+
+				props, err := db.SetQueryTrackingProperties(ctx, arangodb.QueryTrackingProperties{
+					Enabled:            utils.NewType(true),
+					TrackSlowQueries:   utils.NewType(true),
+					TrackBindVars:      utils.NewType(true),
+					SlowQueryThreshold: utils.NewType(0.5),
+				})
+				require.NoError(t, err)
+				require.Equal(t, 0.5, *props.SlowQueryThreshold)
+
+				const query = "RETURN SLEEP(@duration)"
+
+				findQuery := func(queries []arangodb.QueryEntry) (arangodb.QueryEntry, bool) {
+					for _, q := range queries {
+						if q.Query == query {
+							return q, true
+						}
+					}
+					return arangodb.QueryEntry{}, false
+				}
+
+				t.Run("Slow query", func(t *testing.T) {
+					cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+						BindVars: map[string]interface{}{"duration": 1},
+					})
+					require.NoError(t, err)
+					require.NoError(t, cursor.Close())
+
+					queries, err := db.SlowQueries(ctx)
+					require.NoError(t, err)
+
+					slow, found := findQuery(queries)
+					require.True(t, found)
+					require.Equal(t, "finished", slow.State)
+					require.GreaterOrEqual(t, slow.RunTime, 1.0)
+					require.EqualValues(t, 1, slow.BindVars["duration"])
+				})
+
+				t.Run("Kill running query", func(t *testing.T) {
+					done := make(chan error, 1)
+					go func() {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+							BindVars: map[string]interface{}{"duration": 30},
+						})
+						if err == nil {
+							cursor.Close()
+						}
+						done <- err
+					}()
+
+					var running arangodb.QueryEntry
+					NewTimeout(func() error {
+						queries, err := db.RunningQueries(ctx)
+						if err != nil {
+							return err
+						}
+
+						if q, found := findQuery(queries); found {
+							running = q
+							return Interrupt{}
+						}
+						return nil
+					}).TimeoutT(t, 10*time.Second, 100*time.Millisecond)
+
+					require.NotEmpty(t, running.ID)
+					require.Equal(t, db.Name(), running.Database)
+					require.Equal(t, "executing", running.State)
+
+					require.NoError(t, db.KillQuery(ctx, running.ID))
+
+					select {
+					case err := <-done:
+						require.Error(t, err)
+					case <-time.After(20 * time.Second):
+						require.Fail(t, "killed query is still running")
+					}
+
+					err := db.KillQuery(ctx, running.ID)
+					require.True(t, shared.IsNotFound(err))
+				})
+			})
+		})
+	})
+}
+
 // Test_ExplainQuery tries to explain several AQL queries.
 func Test_ExplainQuery(t *testing.T) {
 	rf := arangodb.ReplicationFactor(2)
@@ -98,6 +629,110 @@ func Test_ExplainQuery(t *testing.T) {
 	})
 }
 
+func Test_OptimizerRules(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					rules, err := db.OptimizerRules(ctx)
+					require.NoError(t, err)
+					require.NotEmpty(t, rules)
+
+					var useIndexes *arangodb.OptimizerRule
+					for i := range rules {
+						require.NotEmpty(t, rules[i].Name)
+						if rules[i].Name == "use-indexes" {
+							useIndexes = &rules[i]
+						}
+					}
+					require.NotNil(t, useIndexes, "rule use-indexes is not listed")
+					require.True(t, useIndexes.Flags.CanBeDisabled)
+
+					_, _, err = col.EnsurePersistentIndex(ctx, []string{"name"}, nil)
+					require.NoError(t, err)
+
+					_, err = col.CreateDocuments(ctx, []UserDoc{{Name: "a", Age: 1}, {Name: "b", Age: 2}, {Name: "c", Age: 3}})
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR d IN `%s` FILTER d.name == @name RETURN d", col.Name())
+					bindVars := map[string]interface{}{"name": "b"}
+					disabled := []string{"-use-indexes"}
+
+					t.Run("explain", func(t *testing.T) {
+						explain, err := db.ExplainQuery(ctx, query, bindVars, nil)
+						require.NoError(t, err)
+						require.Contains(t, explain.Plan.Rules, "use-indexes")
+
+						explain, err = db.ExplainQuery(ctx, query, bindVars, &arangodb.ExplainQueryOptions{
+							Optimizer: arangodb.ExplainQueryOptimizerOptions{Rules: disabled},
+						})
+						require.NoError(t, err)
+						require.NotContains(t, explain.Plan.Rules, "use-indexes")
+					})
+
+					t.Run("query", func(t *testing.T) {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+							BindVars: bindVars,
+							Options: arangodb.QuerySubOptions{
+								Optimizer: arangodb.QuerySubOptionsOptimizer{Rules: disabled},
+							},
+						})
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						var doc UserDoc
+						_, err = cursor.ReadDocument(ctx, &doc)
+						require.NoError(t, err)
+						require.Equal(t, "b", doc.Name)
+
+						// Without the index all the documents are scanned.
+						stats := cursor.Statistics()
+						require.Equal(t, uint64(3), stats.ScannedFullInt)
+						require.Equal(t, uint64(0), stats.ScannedIndexInt)
+					})
+				})
+			})
+		})
+	})
+}
+
+func Test_CompareQueryPlans(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					_, _, err := col.EnsurePersistentIndex(ctx, []string{"name"}, nil)
+					require.NoError(t, err)
+
+					_, err = col.CreateDocuments(ctx, []UserDoc{{Name: "a", Age: 1}, {Name: "b", Age: 2}, {Name: "c", Age: 3}})
+					require.NoError(t, err)
+
+					// Adjacent loops can be interchanged by the optimizer, so more than one plan is created.
+					query := fmt.Sprintf("FOR a IN `%s` FOR b IN `%s` FILTER a.name == @name AND b.age == a.age RETURN b",
+						col.Name(), col.Name())
+					bindVars := map[string]interface{}{"name": "b"}
+
+					comparison, err := db.CompareQueryPlans(ctx, query, bindVars, nil)
+					require.NoError(t, err)
+					require.Greater(t, len(comparison.Plans), 1)
+
+					for i := 1; i < len(comparison.Plans); i++ {
+						require.LessOrEqual(t, comparison.Plans[i-1].EstimatedCost, comparison.Plans[i].EstimatedCost)
+					}
+
+					cheapest, ok := comparison.Cheapest()
+					require.True(t, ok)
+
+					explain, err := db.ExplainQuery(ctx, query, bindVars, nil)
+					require.NoError(t, err)
+					require.Equal(t, explain.Plan.EstimatedCost, cheapest.EstimatedCost,
+						"the cheapest plan must be the one chosen by the optimizer")
+				})
+			})
+		})
+	})
+}
+
 func Test_QueryBatchWithRetries(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		WithDatabase(t, client, nil, func(db arangodb.Database) {
@@ -228,3 +863,51 @@ func Test_QueryBatchWithRetries(t *testing.T) {
 		})
 	})
 }
+
+func Test_QuerySkipInaccessibleCollections(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					skipNoEnterprise(client, ctx, t)
+					if os.Getenv("TEST_AUTHENTICATION") == "" {
+						t.Skip("Authentication is disabled")
+					}
+
+					_, err := col.CreateDocument(ctx, UserDoc{Name: "restricted", Age: 1})
+					require.NoError(t, err)
+
+					userName := GenerateUUID("user-skip-inaccessible")
+					_, err = client.CreateUser(ctx, userName, &arangodb.UserOptions{Password: "secret"})
+					require.NoError(t, err)
+					defer client.RemoveUser(ctx, userName)
+
+					require.NoError(t, client.SetDatabaseAccess(ctx, userName, db.Name(), arangodb.GrantReadOnly))
+					require.NoError(t, client.SetCollectionAccess(ctx, userName, db.Name(), col.Name(), arangodb.GrantNone))
+
+					conn := connectionJsonHttp(t)
+					require.NoError(t, conn.SetAuthentication(connection.NewBasicAuth(userName, "secret")))
+					userDB, err := arangodb.NewClient(conn).GetDatabase(ctx, db.Name(), &arangodb.GetDatabaseOptions{SkipExistCheck: true})
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR d IN `%s` RETURN d", col.Name())
+
+					_, err = userDB.Query(ctx, query, nil)
+					require.True(t, shared.IsForbidden(err), "expected a forbidden error, got %v", err)
+
+					cursor, err := userDB.Query(ctx, query, &arangodb.QueryOptions{
+						Options: arangodb.QuerySubOptions{
+							SkipInaccessibleCollections: utils.NewType(true),
+						},
+					})
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					require.False(t, cursor.HasMore(), "the inaccessible collection must be treated as empty")
+				})
+			})
+		})
+	}, WrapOptions{
+		Parallel: utils.NewType(false),
+	})
+}
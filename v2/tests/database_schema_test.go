@@ -0,0 +1,99 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+// Test_DatabaseSchemaSnapshot compares the schema snapshots of two databases.
+func Test_DatabaseSchemaSnapshot(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(dbA arangodb.Database) {
+			WithDatabase(t, client, nil, func(dbB arangodb.Database) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					colName := "schema_snapshot_col"
+					analyzer := arangodb.AnalyzerDefinition{
+						Name: "schema_snapshot_analyzer",
+						Type: arangodb.ArangoSearchAnalyzerTypeIdentity,
+					}
+
+					for _, db := range []arangodb.Database{dbA, dbB} {
+						_, err := db.CreateCollection(ctx, colName, nil)
+						require.NoError(t, err)
+
+						_, _, err = db.EnsureAnalyzer(ctx, &analyzer)
+						require.NoError(t, err)
+					}
+
+					snapshotA, err := dbA.SchemaSnapshot(ctx)
+					require.NoError(t, err)
+					require.Len(t, snapshotA.Collections, 1)
+					require.Equal(t, colName, snapshotA.Collections[0].Name)
+					require.NotEmpty(t, snapshotA.Collections[0].Indexes)
+					require.NotEmpty(t, snapshotA.Analyzers)
+
+					snapshotB, err := dbB.SchemaSnapshot(ctx)
+					require.NoError(t, err)
+
+					diff := arangodb.DiffSchema(snapshotA, snapshotB)
+					require.True(t, diff.IsEmpty(), "unexpected diff: %+v", diff)
+
+					t.Run("snapshot is serializable", func(t *testing.T) {
+						data, err := json.Marshal(snapshotA)
+						require.NoError(t, err)
+
+						var restored arangodb.SchemaSnapshot
+						require.NoError(t, json.Unmarshal(data, &restored))
+						require.True(t, arangodb.DiffSchema(snapshotA, restored).IsEmpty())
+					})
+
+					t.Run("detect changes", func(t *testing.T) {
+						col, err := dbB.Collection(ctx, colName)
+						require.NoError(t, err)
+						_, _, err = col.EnsurePersistentIndex(ctx, []string{"name"}, nil)
+						require.NoError(t, err)
+
+						_, err = dbB.CreateCollection(ctx, "schema_snapshot_added", nil)
+						require.NoError(t, err)
+
+						snapshotB, err := dbB.SchemaSnapshot(ctx)
+						require.NoError(t, err)
+
+						diff := arangodb.DiffSchema(snapshotA, snapshotB)
+						require.Equal(t, []string{"schema_snapshot_added"}, diff.Collections.Added)
+						require.Equal(t, []string{colName}, diff.Collections.Changed)
+						require.Empty(t, diff.Collections.Removed)
+						require.True(t, diff.Analyzers.IsEmpty())
+						require.True(t, diff.Graphs.IsEmpty())
+						require.True(t, diff.Views.IsEmpty())
+					})
+				})
+			})
+		})
+	})
+}
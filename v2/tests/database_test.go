@@ -86,3 +86,75 @@ func TestDatabaseNameUnicode(t *testing.T) {
 		})
 	})
 }
+
+func TestDatabaseEngineInfo(t *testing.T) {
+	Wrap(t, func(t *testing.T, c arangodb.Client) {
+		WithDatabase(t, c, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				info, err := db.EngineInfo(ctx)
+				require.NoError(t, err)
+				require.Equal(t, arangodb.EngineTypeRocksDB, info.Type)
+				require.NotEmpty(t, info.Supports)
+
+				t.Run("Unload is not supported", func(t *testing.T) {
+					WithCollection(t, db, nil, func(col arangodb.Collection) {
+						err := col.Unload(ctx)
+						require.Error(t, err)
+						require.True(t, arangodb.IsEngineNotSupported(err))
+						require.EqualError(t, err, "operation 'unload' is unsupported on the 'rocksdb' engine")
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestDatabaseVersion(t *testing.T) {
+	Wrap(t, func(t *testing.T, c arangodb.Client) {
+		WithDatabase(t, c, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				version, err := db.Version(ctx)
+				require.NoError(t, err)
+				require.Equal(t, "arango", version.Server)
+				require.NotEmpty(t, version.Version)
+
+				clientVersion, err := c.Version(ctx)
+				require.NoError(t, err)
+				require.Equal(t, clientVersion.Version, version.Version)
+
+				require.Equal(t, version.Version.CompareTo("3.12") >= 0, version.Version.SupportsFeature(arangodb.FeatureMDIIndex))
+			})
+		})
+	})
+}
+
+func TestCreateDatabaseDefaultOptions(t *testing.T) {
+	requireClusterMode(t)
+
+	opts := arangodb.CreateDatabaseOptions{
+		Options: arangodb.CreateDatabaseDefaultOptions{
+			ReplicationFactor: 2,
+			WriteConcern:      1,
+		},
+	}
+
+	Wrap(t, func(t *testing.T, c arangodb.Client) {
+		WithDatabase(t, c, &opts, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				info, err := db.Info(ctx)
+				require.NoError(t, err)
+				require.Equal(t, arangodb.ReplicationFactor(2), info.ReplicationFactor)
+				require.Equal(t, 1, info.WriteConcern)
+
+				t.Run("Collection inherits the defaults", func(t *testing.T) {
+					WithCollection(t, db, nil, func(col arangodb.Collection) {
+						props, err := col.Properties(ctx)
+						require.NoError(t, err)
+						require.Equal(t, arangodb.ReplicationFactor(2), props.ReplicationFactor)
+						require.Equal(t, 1, props.WriteConcern)
+					})
+				})
+			})
+		})
+	})
+}
@@ -244,6 +244,94 @@ func Test_DatabaseTransactions_DocumentLock(t *testing.T) {
 	})
 }
 
+func Test_DatabaseTransactions_ExclusiveLock(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				WithCollection(t, db, nil, func(col arangodb.Collection) {
+					d := document{
+						basicDocument: basicDocument{Key: GenerateUUID("test-doc-exclusive")},
+						Fields:        "initial",
+					}
+					_, err := col.CreateDocument(ctx, d)
+					require.NoError(t, err)
+
+					update := func(col arangodb.Collection, fields string) error {
+						_, err := col.UpdateDocument(ctx, d.Key, map[string]interface{}{"data": fields})
+						return err
+					}
+
+					t.Run("write lock conflicts", func(t *testing.T) {
+						cols := arangodb.TransactionCollections{Write: []string{col.Name()}}
+
+						t1, err := db.BeginTransaction(ctx, cols, &arangodb.BeginTransactionOptions{LockTimeoutDuration: time.Second})
+						require.NoError(t, err)
+						defer abortTransaction(t, t1)
+
+						t2, err := db.BeginTransaction(ctx, cols, &arangodb.BeginTransactionOptions{LockTimeoutDuration: time.Second})
+						require.NoError(t, err)
+						defer abortTransaction(t, t2)
+
+						col1, err := t1.GetCollection(ctx, col.Name(), nil)
+						require.NoError(t, err)
+						col2, err := t2.GetCollection(ctx, col.Name(), nil)
+						require.NoError(t, err)
+
+						require.NoError(t, update(col1, "t1"))
+
+						err = update(col2, "t2")
+						require.Error(t, err)
+						require.True(t, shared.IsConflict(err) || shared.IsOperationTimeout(err), err)
+					})
+
+					t.Run("exclusive lock serializes", func(t *testing.T) {
+						cols := arangodb.TransactionCollections{Exclusive: []string{col.Name()}}
+
+						t1, err := db.BeginTransaction(ctx, cols, &arangodb.BeginTransactionOptions{LockTimeoutDuration: 10 * time.Second})
+						require.NoError(t, err)
+
+						col1, err := t1.GetCollection(ctx, col.Name(), nil)
+						require.NoError(t, err)
+						require.NoError(t, update(col1, "t1"))
+
+						type beginResult struct {
+							transaction arangodb.Transaction
+							err         error
+						}
+						began := make(chan beginResult, 1)
+						go func() {
+							t2, err := db.BeginTransaction(ctx, cols, &arangodb.BeginTransactionOptions{LockTimeoutDuration: 10 * time.Second})
+							began <- beginResult{transaction: t2, err: err}
+						}()
+
+						select {
+						case <-began:
+							require.Fail(t, "second exclusive transaction began before the first one finished")
+						case <-time.After(time.Second):
+						}
+
+						require.NoError(t, t1.Commit(ctx, nil))
+
+						result := <-began
+						require.NoError(t, result.err)
+						t2 := result.transaction
+
+						col2, err := t2.GetCollection(ctx, col.Name(), nil)
+						require.NoError(t, err)
+						require.NoError(t, update(col2, "t2"))
+						require.NoError(t, t2.Commit(ctx, nil))
+
+						var doc document
+						_, err = col.ReadDocument(ctx, d.Key, &doc)
+						require.NoError(t, err)
+						require.Equal(t, "t2", doc.Fields)
+					})
+				})
+			})
+		})
+	})
+}
+
 func Test_DatabaseTransactions_List(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
@@ -278,6 +366,63 @@ func Test_DatabaseTransactions_List(t *testing.T) {
 	})
 }
 
+func Test_DatabaseTransactions_MultiCollectionWrite(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col1 arangodb.Collection) {
+				WithCollection(t, db, nil, func(col2 arangodb.Collection) {
+					withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+						t.Run("Commit all operations", func(t *testing.T) {
+							err := db.MultiCollectionWrite(ctx, []arangodb.WriteOp{
+								{Collection: col1.Name(), Type: arangodb.WriteOpTypeInsert, Document: map[string]interface{}{"_key": "john", "name": "John"}},
+								{Collection: col2.Name(), Type: arangodb.WriteOpTypeInsert, Document: map[string]interface{}{"_key": "john", "name": "John"}},
+								{Collection: col1.Name(), Type: arangodb.WriteOpTypeUpdate, Key: "john", Document: map[string]interface{}{"age": 23}},
+							})
+							require.NoError(t, err)
+
+							var doc UserDocWithMeta
+							_, err = col1.ReadDocument(ctx, "john", &doc)
+							require.NoError(t, err)
+							require.Equal(t, 23, doc.Age)
+
+							_, err = col2.ReadDocument(ctx, "john", &doc)
+							require.NoError(t, err)
+							require.Equal(t, "John", doc.Name)
+						})
+
+						t.Run("Abort all operations when one of them fails", func(t *testing.T) {
+							err := db.MultiCollectionWrite(ctx, []arangodb.WriteOp{
+								{Collection: col1.Name(), Type: arangodb.WriteOpTypeDelete, Key: "john"},
+								{Collection: col2.Name(), Type: arangodb.WriteOpTypeReplace, Key: "john", Document: UserDoc{Name: "Jake"}},
+								{Collection: col2.Name(), Type: arangodb.WriteOpTypeUpdate, Key: "not-existing", Document: UserDoc{}},
+							})
+							require.Error(t, err)
+							require.True(t, shared.IsNotFound(err))
+
+							exists, err := col1.DocumentExists(ctx, "john")
+							require.NoError(t, err)
+							require.True(t, exists)
+
+							var doc UserDocWithMeta
+							_, err = col2.ReadDocument(ctx, "john", &doc)
+							require.NoError(t, err)
+							require.Equal(t, "John", doc.Name)
+						})
+
+						t.Run("Invalid operation", func(t *testing.T) {
+							err := db.MultiCollectionWrite(ctx, []arangodb.WriteOp{
+								{Collection: col1.Name(), Type: arangodb.WriteOpTypeDelete},
+							})
+							require.Error(t, err)
+							require.True(t, shared.IsInvalidArgument(err))
+						})
+					})
+				})
+			})
+		})
+	})
+}
+
 func ensureTransactionStatus(t testing.TB, db arangodb.Database, tid arangodb.TransactionID, status arangodb.TransactionStatus) {
 	withContextT(t, 30*time.Second, func(ctx context.Context, t testing.TB) {
 		transaction, err := db.Transaction(ctx, tid)
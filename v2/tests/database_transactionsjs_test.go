@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/utils"
 )
 
 func Test_DatabaseTransactionsJS(t *testing.T) {
@@ -52,6 +53,24 @@ func Test_DatabaseTransactionsJS(t *testing.T) {
 					})
 				})
 
+				t.Run("Transaction with params", func(t *testing.T) {
+					withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+						txJSOptions := arangodb.TransactionJSOptions{
+							Action: "function (params) { return Number(params[0]) * Number(params[1]); }",
+							Params: []string{"6", "7"},
+							Collections: arangodb.TransactionCollections{
+								Read: []string{col.Name()},
+							},
+							LockTimeout:        utils.NewType(10),
+							MaxTransactionSize: utils.NewType(1024 * 1024),
+						}
+
+						result, err := db.TransactionJS(ctx, txJSOptions)
+						require.NoError(t, err)
+						require.EqualValues(t, 42, result)
+					})
+				})
+
 				t.Run("Transaction ReturnError", func(t *testing.T) {
 					withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
 						txJSOptions := arangodb.TransactionJSOptions{
@@ -954,3 +954,45 @@ func Test_ArangoSearchViewInMemoryCache(t *testing.T) {
 		})
 	})
 }
+
+// Test_ArangoSearchViewStats creates a view with a link, fills the linked collection and checks the view statistics.
+func Test_ArangoSearchViewStats(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					view := ensureArangoSearchView(ctx, db, "stats_view", &arangodb.ArangoSearchViewProperties{
+						Links: arangodb.ArangoSearchLinks{
+							col.Name(): arangodb.ArangoSearchElementProperties{
+								IncludeAllFields: utils.NewType(true),
+							},
+						},
+					}, t)
+
+					docs := []UserDoc{
+						{"John", 23},
+						{"Alice", 43},
+						{"Helmut", 56},
+					}
+					insertBatch(t, ctx, col, nil, docs)
+
+					// make sure that the data is committed to the view
+					cur, err := db.Query(ctx, "FOR doc IN stats_view OPTIONS {waitForSync:true} RETURN doc", nil)
+					require.NoError(t, err)
+					require.NoError(t, cur.Close())
+
+					stats, err := view.Stats(ctx)
+					require.NoError(t, err)
+					require.Len(t, stats.Links, 1)
+
+					linkStats, ok := stats.Links[col.Name()]
+					require.True(t, ok)
+					require.Equal(t, int64(len(docs)), linkStats.NumLiveDocs)
+					require.Equal(t, int64(0), linkStats.NumDeadDocs())
+					require.GreaterOrEqual(t, linkStats.NumSegments, int64(1))
+					require.Equal(t, linkStats, stats.ViewLinkStats)
+				})
+			})
+		})
+	})
+}
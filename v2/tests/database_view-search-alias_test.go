@@ -147,6 +147,69 @@ func Test_ArangoSearchAliasView(t *testing.T) {
 	})
 }
 
+// Test_ArangoSearchAliasViewQuery searches the documents through a search-alias view.
+func Test_ArangoSearchAliasViewQuery(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					skipBelowVersion(client, ctx, "3.10", t)
+
+					indexName := "inv_index_alias_query"
+					_, _, err := col.EnsureInvertedIndex(ctx, &arangodb.InvertedIndexOptions{
+						Name:   indexName,
+						Fields: []arangodb.InvertedIndexField{{Name: "name"}},
+					})
+					require.NoError(t, err)
+
+					_, err = col.CreateDocuments(ctx, []UserDoc{{Name: "John", Age: 13}, {Name: "Jake", Age: 14}})
+					require.NoError(t, err)
+
+					view, err := db.CreateArangoSearchAliasView(ctx, "test_alias_view_query", &arangodb.ArangoSearchAliasViewProperties{
+						Indexes: []arangodb.ArangoSearchAliasIndex{
+							{Collection: col.Name(), Index: indexName},
+						},
+					})
+					require.NoError(t, err)
+
+					query := "FOR d IN @@view SEARCH d.name == @name OPTIONS { waitForSync: true } RETURN d"
+					cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+						BindVars: map[string]interface{}{
+							"@view": view.Name(),
+							"name":  "John",
+						},
+					})
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					var docs []UserDoc
+					for cursor.HasMore() {
+						var doc UserDoc
+						_, err := cursor.ReadDocument(ctx, &doc)
+						require.NoError(t, err)
+						docs = append(docs, doc)
+					}
+					require.Equal(t, []UserDoc{{Name: "John", Age: 13}}, docs)
+
+					t.Run("remove the index from the view", func(t *testing.T) {
+						err := view.UpdateProperties(ctx, arangodb.ArangoSearchAliasUpdateOpts{
+							Indexes: []arangodb.ArangoSearchAliasIndex{
+								{Collection: col.Name(), Index: indexName},
+							},
+							Operation: arangodb.ArangoSearchAliasOperationDel,
+						})
+						require.NoError(t, err)
+
+						props, err := view.Properties(ctx)
+						require.NoError(t, err)
+						require.Empty(t, props.Indexes)
+					})
+				})
+			})
+		})
+	})
+}
+
 func sampleIndex(nameInvInd string) *arangodb.InvertedIndexOptions {
 	indexOpt := arangodb.InvertedIndexOptions{
 		Name: nameInvInd,
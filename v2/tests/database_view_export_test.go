@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// Test_ExportImportViews exports the view definitions and restores them.
+func Test_ExportImportViews(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					skipBelowVersion(client, ctx, "3.10", t)
+
+					searchName := "test_export_asview"
+					aliasName := "test_export_alias_view"
+					indexName := "inv_index_export_view"
+
+					_, err := db.CreateArangoSearchView(ctx, searchName, &arangodb.ArangoSearchViewProperties{
+						Links: arangodb.ArangoSearchLinks{
+							col.Name(): arangodb.ArangoSearchElementProperties{},
+						},
+					})
+					require.NoError(t, err)
+
+					_, _, err = col.EnsureInvertedIndex(ctx, sampleIndex(indexName))
+					require.NoError(t, err)
+
+					_, err = db.CreateArangoSearchAliasView(ctx, aliasName, &arangodb.ArangoSearchAliasViewProperties{
+						Indexes: []arangodb.ArangoSearchAliasIndex{
+							{Collection: col.Name(), Index: indexName},
+						},
+					})
+					require.NoError(t, err)
+
+					defs, err := db.ExportViews(ctx)
+					require.NoError(t, err)
+					require.Len(t, defs, 2)
+
+					byName := map[string]arangodb.ViewDefinition{}
+					for _, def := range defs {
+						byName[def.Name] = def
+					}
+
+					require.Equal(t, arangodb.ViewTypeArangoSearch, byName[searchName].Type)
+					require.NotNil(t, byName[searchName].ArangoSearch)
+					require.Nil(t, byName[searchName].SearchAlias)
+					require.Contains(t, byName[searchName].ArangoSearch.Links, col.Name())
+
+					require.Equal(t, arangodb.ViewTypeSearchAlias, byName[aliasName].Type)
+					require.NotNil(t, byName[aliasName].SearchAlias)
+					require.Nil(t, byName[aliasName].ArangoSearch)
+					require.Len(t, byName[aliasName].SearchAlias.Indexes, 1)
+
+					t.Run("import removed views", func(t *testing.T) {
+						for _, name := range []string{searchName, aliasName} {
+							v, err := db.View(ctx, name)
+							require.NoError(t, err)
+							require.NoError(t, v.Remove(ctx))
+						}
+
+						for _, def := range defs {
+							require.NoError(t, db.ImportView(ctx, def))
+						}
+
+						v, err := db.View(ctx, searchName)
+						require.NoError(t, err)
+						searchView, err := v.ArangoSearchView()
+						require.NoError(t, err)
+						props, err := searchView.Properties(ctx)
+						require.NoError(t, err)
+						require.Contains(t, props.Links, col.Name())
+
+						v, err = db.View(ctx, aliasName)
+						require.NoError(t, err)
+						aliasView, err := v.ArangoSearchViewAlias()
+						require.NoError(t, err)
+						aliasProps, err := aliasView.Properties(ctx)
+						require.NoError(t, err)
+						require.Len(t, aliasProps.Indexes, 1)
+						require.Equal(t, indexName, aliasProps.Indexes[0].Index)
+					})
+
+					t.Run("import converges existing view", func(t *testing.T) {
+						def := byName[aliasName]
+						def.SearchAlias = &arangodb.ArangoSearchAliasViewProperties{}
+						require.NoError(t, db.ImportView(ctx, def))
+
+						v, err := db.View(ctx, aliasName)
+						require.NoError(t, err)
+						aliasView, err := v.ArangoSearchViewAlias()
+						require.NoError(t, err)
+						aliasProps, err := aliasView.Properties(ctx)
+						require.NoError(t, err)
+						require.Empty(t, aliasProps.Indexes)
+					})
+
+					t.Run("import with different type", func(t *testing.T) {
+						def := byName[aliasName]
+						def.Type = arangodb.ViewTypeArangoSearch
+
+						err := db.ImportView(ctx, def)
+						require.Error(t, err)
+						require.True(t, shared.IsInvalidArgument(err))
+					})
+				})
+			})
+		})
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func Test_EdgeSimple(t *testing.T) {
@@ -72,6 +73,17 @@ func Test_EdgeSimple(t *testing.T) {
 
 					})
 
+					t.Run("Create Edge to nonexistent vertex", func(t *testing.T) {
+						_, err := edgeDefResp.Edge.CreateEdge(ctx, RouteEdge{
+							From:     string(fromVertex.ID),
+							To:       toColName + "/nonexistent",
+							Distance: 1,
+						}, nil)
+						require.Error(t, err)
+						require.True(t, shared.IsNotFound(err))
+						require.True(t, shared.IsArangoErrorWithErrorNum(err, shared.ErrArangoDocumentNotFound))
+					})
+
 					t.Run("Get Edge documents", func(t *testing.T) {
 						edges, err := db.GetEdges(ctx, edgeColName, string(fromVertex.ID), nil)
 						require.NoError(t, err)
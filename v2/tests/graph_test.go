@@ -280,3 +280,47 @@ func Test_GraphRemoval(t *testing.T) {
 		})
 	})
 }
+
+func Test_GraphRemovalDropCollections(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+				g, err := db.CreateGraph(ctx, "removed", &arangodb.GraphDefinition{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{Collection: "edges", From: []string{"from"}, To: []string{"shared"}},
+					},
+					OrphanCollections: []string{"orphans"},
+				}, nil)
+				require.NoError(t, err)
+
+				other, err := db.CreateGraph(ctx, "kept", &arangodb.GraphDefinition{
+					OrphanCollections: []string{"shared"},
+				}, nil)
+				require.NoError(t, err)
+				defer func() {
+					require.NoError(t, other.Remove(ctx, &arangodb.RemoveGraphOptions{DropCollections: true}))
+				}()
+
+				// The edge definition is added after the graph was opened.
+				_, err = g.CreateEdgeDefinition(ctx, "added", []string{"from"}, []string{"to"}, nil)
+				require.NoError(t, err)
+
+				require.NoError(t, g.Remove(ctx, &arangodb.RemoveGraphOptions{DropCollections: true}))
+
+				exist, err := db.GraphExists(ctx, g.Name())
+				require.NoError(t, err)
+				require.False(t, exist, "graph should not exist")
+
+				for _, name := range []string{"edges", "from", "orphans", "added", "to"} {
+					exist, err := db.CollectionExists(ctx, name)
+					require.NoError(t, err)
+					require.False(t, exist, "collection %s should not exist", name)
+				}
+
+				exist, err = db.CollectionExists(ctx, "shared")
+				require.NoError(t, err)
+				require.True(t, exist, "collection used by another graph should exist")
+			})
+		})
+	})
+}
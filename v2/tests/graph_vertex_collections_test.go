@@ -79,6 +79,42 @@ func Test_GraphVertexCollections(t *testing.T) {
 	})
 }
 
+func Test_GraphVertexCollectionsDropCollection(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithGraph(t, db, nil, nil, func(graph arangodb.Graph) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					colName := "test_orphan_collection"
+
+					createResp, err := graph.CreateVertexCollection(ctx, colName, nil)
+					require.NoError(t, err)
+					require.Contains(t, createResp.GraphDefinition.OrphanCollections, colName)
+
+					cols, err := graph.VertexCollections(ctx)
+					require.NoError(t, err)
+					require.Len(t, cols, 1)
+					require.Equal(t, colName, cols[0].Name())
+
+					opts := arangodb.DeleteVertexCollectionOptions{
+						DropCollection: utils.NewType(true),
+					}
+					delResp, err := graph.DeleteVertexCollection(ctx, colName, &opts)
+					require.NoError(t, err)
+					require.NotContains(t, delResp.GraphDefinition.OrphanCollections, colName)
+
+					exist, err := graph.VertexCollectionExists(ctx, colName)
+					require.NoError(t, err)
+					require.False(t, exist, "vertex collection should not be in the graph")
+
+					exist, err = db.CollectionExists(ctx, colName)
+					require.NoError(t, err)
+					require.False(t, exist, "collection should not exist")
+				})
+			})
+		})
+	})
+}
+
 func TestCreateSatelliteVertexCollection(t *testing.T) {
 	Wrap(t, func(t *testing.T, client arangodb.Client) {
 		requireClusterMode(t)
@@ -22,6 +22,8 @@ package tests
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/arangodb/go-driver/v2/utils"
@@ -29,6 +31,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
 )
 
 func Test_UserPermission(t *testing.T) {
@@ -121,3 +125,147 @@ func Test_UserPermission(t *testing.T) {
 		Parallel: utils.NewType(false),
 	})
 }
+
+func Test_CreateDatabaseWithUsers(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+			userName := GenerateUUID("user-db-initial")
+			defer client.RemoveUser(ctx, userName)
+
+			opts := &arangodb.CreateDatabaseOptions{
+				Users: []arangodb.CreateDatabaseUserOptions{
+					{
+						UserName: userName,
+						Password: "secret",
+						Active:   utils.NewType(true),
+						Extra:    map[string]string{"team": "drivers"},
+					},
+				},
+			}
+
+			WithDatabase(t, client, opts, func(db arangodb.Database) {
+				user, err := client.User(ctx, userName)
+				require.NoError(t, err)
+				require.True(t, user.IsActive())
+
+				var extra map[string]string
+				require.NoError(t, user.Extra(&extra))
+				require.Equal(t, "drivers", extra["team"])
+
+				dbAccess, err := user.GetDatabaseAccess(ctx, db.Name())
+				require.NoError(t, err)
+				require.Equal(t, arangodb.GrantReadWrite, dbAccess)
+
+				WithCollection(t, db, nil, func(col arangodb.Collection) {
+					_, err := col.CreateDocument(ctx, UserDoc{Name: "initial", Age: 1})
+					require.NoError(t, err)
+
+					require.NoError(t, db.SetCollectionAccess(ctx, userName, col.Name(), arangodb.GrantReadOnly))
+
+					colAccess, err := user.GetCollectionAccess(ctx, db.Name(), col.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadOnly, colAccess)
+
+					if os.Getenv("TEST_AUTHENTICATION") == "" {
+						t.Skip("Authentication is disabled")
+					}
+
+					conn := connectionJsonHttp(t)
+					require.NoError(t, conn.SetAuthentication(connection.NewBasicAuth(userName, "secret")))
+
+					userDB, err := arangodb.NewClient(conn).GetDatabase(ctx, db.Name(), nil)
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR d IN `%s` RETURN d", col.Name())
+					cursor, err := userDB.Query(ctx, query, nil)
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					var doc UserDoc
+					_, err = cursor.ReadDocument(ctx, &doc)
+					require.NoError(t, err)
+					require.Equal(t, "initial", doc.Name)
+
+					userCol, err := userDB.GetCollection(ctx, col.Name(), nil)
+					require.NoError(t, err)
+					_, err = userCol.CreateDocument(ctx, UserDoc{Name: "denied"})
+					require.True(t, shared.IsForbidden(err), "write to the read-only collection must be forbidden, got %v", err)
+				})
+			})
+		})
+	}, WrapOptions{
+		Parallel: utils.NewType(false),
+	})
+}
+
+func Test_ClientUsersAccess(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					userName := GenerateUUID("user-client-access")
+					_, err := client.CreateUser(ctx, userName, &arangodb.UserOptions{Password: "secret"})
+					require.NoError(t, err)
+					defer client.RemoveUser(ctx, userName)
+
+					access, err := client.GetDatabaseAccess(ctx, userName, db.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantNone, access)
+
+					require.NoError(t, client.SetDatabaseAccess(ctx, userName, db.Name(), arangodb.GrantReadOnly))
+
+					access, err = client.GetDatabaseAccess(ctx, userName, db.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadOnly, access)
+
+					require.NoError(t, client.SetCollectionAccess(ctx, userName, db.Name(), col.Name(), arangodb.GrantReadWrite))
+
+					user, err := client.User(ctx, userName)
+					require.NoError(t, err)
+					colAccess, err := user.GetCollectionAccess(ctx, db.Name(), col.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadWrite, colAccess)
+
+					_, err = client.GetDatabaseAccess(ctx, GenerateUUID("user-missing"), db.Name())
+					require.True(t, shared.IsNotFound(err))
+				})
+			})
+		})
+	}, WrapOptions{
+		Parallel: utils.NewType(false),
+	})
+}
+
+func Test_UserDefaultAccess(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+			user, err := client.CreateUser(ctx, GenerateUUID("user-default-access"), nil)
+			require.NoError(t, err)
+			defer client.RemoveUser(ctx, user.Name())
+
+			require.NoError(t, user.SetDefaultDatabaseAccess(ctx, arangodb.GrantReadWrite))
+
+			// The database is created after the wildcard grant and the user is not granted explicitly.
+			WithDatabase(t, client, nil, func(db arangodb.Database) {
+				dbAccess, err := user.GetDatabaseAccess(ctx, db.Name())
+				require.NoError(t, err)
+				require.Equal(t, arangodb.GrantReadWrite, dbAccess)
+
+				require.NoError(t, user.SetDefaultCollectionAccess(ctx, db.Name(), arangodb.GrantReadOnly))
+
+				WithCollection(t, db, nil, func(col arangodb.Collection) {
+					colAccess, err := user.GetCollectionAccess(ctx, db.Name(), col.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadOnly, colAccess)
+
+					permissions, err := user.AccessibleDatabasesFull(ctx)
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadWrite, permissions["*"].Permission)
+					require.Equal(t, arangodb.GrantReadOnly, permissions[db.Name()].Collections["*"])
+				})
+			})
+		})
+	}, WrapOptions{
+		Parallel: utils.NewType(false),
+	})
+}
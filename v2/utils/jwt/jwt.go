@@ -24,6 +24,8 @@
 package jwt
 
 import (
+	"time"
+
 	"github.com/golang-jwt/jwt"
 	"github.com/pkg/errors"
 )
@@ -37,23 +39,7 @@ const (
 // If the secret is empty, nothing is done.
 // Use the result of this function as input for driver.RawAuthentication.
 func CreateArangodJwtAuthorizationHeader(jwtSecret, serverID string) (string, error) {
-	if jwtSecret == "" || serverID == "" {
-		return "", nil
-	}
-	// Create a new token object, specifying signing method and the claims
-	// you would like it to contain.
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"iss":       issArangod,
-		"server_id": serverID,
-	})
-
-	// Sign and get the complete encoded token as a string using the secret
-	signedToken, err := token.SignedString([]byte(jwtSecret))
-	if err != nil {
-		return "", errors.WithStack(err)
-	}
-
-	return "bearer " + signedToken, nil
+	return createArangodJwtAuthorizationHeader(jwtSecret, serverID, jwt.MapClaims{})
 }
 
 // CreateArangodJwtAuthorizationHeaderAllowedPaths calculates a JWT authorization header, for authorization
@@ -62,16 +48,34 @@ func CreateArangodJwtAuthorizationHeader(jwtSecret, serverID string) (string, er
 // Use the result of this function as input for driver.RawAuthentication.
 // Additionally allowed paths can be specified
 func CreateArangodJwtAuthorizationHeaderAllowedPaths(jwtSecret, serverID string, paths []string) (string, error) {
+	return createArangodJwtAuthorizationHeader(jwtSecret, serverID, jwt.MapClaims{
+		"allowed_paths": paths,
+	})
+}
+
+// CreateArangodJwtAuthorizationHeaderExpiring calculates a JWT authorization header, for authorization
+// of a request to an arangod server, based on the given secret.
+// The token is issued at the given time, and it is not accepted by the server after the expiration time.
+// If the secret is empty, nothing is done.
+func CreateArangodJwtAuthorizationHeaderExpiring(jwtSecret, serverID string, issuedAt, expiresAt time.Time) (string, error) {
+	return createArangodJwtAuthorizationHeader(jwtSecret, serverID, jwt.MapClaims{
+		"iat": issuedAt.Unix(),
+		"exp": expiresAt.Unix(),
+	})
+}
+
+// createArangodJwtAuthorizationHeader signs the token with the arangod issuer, the server ID and the given claims.
+func createArangodJwtAuthorizationHeader(jwtSecret, serverID string, claims jwt.MapClaims) (string, error) {
 	if jwtSecret == "" || serverID == "" {
 		return "", nil
 	}
+
+	claims["iss"] = issArangod
+	claims["server_id"] = serverID
+
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"iss":           issArangod,
-		"server_id":     serverID,
-		"allowed_paths": paths,
-	})
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	// Sign and get the complete encoded token as a string using the secret
 	signedToken, err := token.SignedString([]byte(jwtSecret))
@@ -156,6 +156,7 @@ func (c *vstConnection) do(ctx context.Context, req driver.Request, transport me
 	if !ok {
 		return nil, driver.WithStack(driver.InvalidArgumentError{Message: "request is not a *vstRequest"})
 	}
+	driver.ApplyHeaders(ctx, req, vstReq.hdr)
 	msgParts, err := vstReq.createMessageParts()
 	if err != nil {
 		return nil, driver.WithStack(err)